@@ -0,0 +1,176 @@
+package lawtest
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+)
+
+// ===========================================================================
+// APPROXIMATE EQUALITY
+// ===========================================================================
+
+// Approximate is the set of types whose values are compared within a
+// tolerance rather than with ==: floating-point and complex numbers.
+type Approximate interface {
+	~float32 | ~float64 | ~complex64 | ~complex128
+}
+
+// ComplexGen creates a Generator that produces complex numbers whose real
+// and imaginary parts are drawn from re and im.
+//
+// Example:
+//
+//	gen := lawtest.ComplexGen(lawtest.Float64Gen(-10, 10), lawtest.Float64Gen(-10, 10))
+//	mul := func(a, b complex128) complex128 { return a * b }
+//	lawtest.CommutativeApprox(t, mul, gen, 1e-9)
+func ComplexGen(re, im Generator[float64]) Generator[complex128] {
+	return func() complex128 {
+		return complex(re(), im())
+	}
+}
+
+// ApproxEqual reports whether a and b differ by at most epsilon.
+//
+// For complex numbers the difference is the modulus |a-b|. Equal
+// infinities are approximately equal; NaN is never approximately equal to
+// anything, including another NaN.
+func ApproxEqual[T Approximate](a, b T, epsilon float64) bool {
+	return a == b || distance(a, b) <= epsilon
+}
+
+// isNaN reports whether v is NaN, or for complex numbers has a NaN part.
+func isNaN[T Approximate](v T) bool {
+	return v != v
+}
+
+// approxFailure describes why left and right are not approximately equal,
+// calling out NaN explicitly since it hides behind an ordinary mismatch.
+func approxFailure[T Approximate](left, right T) string {
+	if isNaN(left) || isNaN(right) {
+		return "result is NaN (NaN is never approximately equal)"
+	}
+	return fmt.Sprintf("diff=%g", distance(left, right))
+}
+
+// distance returns |a-b| as a float64.
+func distance[T Approximate](a, b T) float64 {
+	d := reflect.ValueOf(a - b)
+	switch d.Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		return cmplx.Abs(d.Complex())
+	default:
+		return math.Abs(d.Float())
+	}
+}
+
+// AssociativeApprox tests associativity within a tolerance:
+// |(a ∘ b) ∘ c - a ∘ (b ∘ c)| <= epsilon.
+//
+// Floating-point arithmetic rounds after every operation, so exact
+// associativity fails even for addition. Choose epsilon relative to the
+// magnitude of the generated values.
+//
+// Example:
+//
+//	func TestFloatAddition(t *testing.T) {
+//	    add := func(a, b float64) float64 { return a + b }
+//	    lawtest.AssociativeApprox(t, add, lawtest.Float64Gen(-100, 100), 1e-9)
+//	}
+func AssociativeApprox[T Approximate](t TB, op BinaryOp[T], gen Generator[T], epsilon float64) {
+	AssociativeApproxWithConfig(t, op, gen, epsilon, DefaultConfig())
+}
+
+// AssociativeApproxWithConfig tests approximate associativity with custom configuration.
+func AssociativeApproxWithConfig[T Approximate](t TB, op BinaryOp[T], gen Generator[T], epsilon float64, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a, b, c := gen(), gen(), gen()
+
+		// (a ∘ b) ∘ c
+		left := op(op(a, b), c)
+
+		// a ∘ (b ∘ c)
+		right := op(a, op(b, c))
+
+		if !ApproxEqual(left, right, epsilon) {
+			return cfg.sprintf("Associativity failed: |(a∘b)∘c - a∘(b∘c)| > %g\n  a=%v, b=%v, c=%v\n  left=%v, right=%v, %s",
+				epsilon, a, b, c, left, right, approxFailure(left, right))
+		}
+
+		return ""
+	})
+}
+
+// CommutativeApprox tests commutativity within a tolerance:
+// |a ∘ b - b ∘ a| <= epsilon.
+//
+// Example:
+//
+//	func TestComplexMultiplication(t *testing.T) {
+//	    mul := func(a, b complex128) complex128 { return a * b }
+//	    gen := lawtest.ComplexGen(lawtest.Float64Gen(-10, 10), lawtest.Float64Gen(-10, 10))
+//	    lawtest.CommutativeApprox(t, mul, gen, 1e-9)
+//	}
+func CommutativeApprox[T Approximate](t TB, op BinaryOp[T], gen Generator[T], epsilon float64) {
+	CommutativeApproxWithConfig(t, op, gen, epsilon, DefaultConfig())
+}
+
+// CommutativeApproxWithConfig tests approximate commutativity with custom configuration.
+func CommutativeApproxWithConfig[T Approximate](t TB, op BinaryOp[T], gen Generator[T], epsilon float64, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+
+		left := op(a, b)
+		right := op(b, a)
+
+		if !ApproxEqual(left, right, epsilon) {
+			return cfg.sprintf("Commutativity failed: |a∘b - b∘a| > %g\n  a=%v, b=%v\n  a∘b=%v, b∘a=%v, %s",
+				epsilon, a, b, left, right, approxFailure(left, right))
+		}
+
+		return ""
+	})
+}
+
+// IdentityApprox tests an identity element within a tolerance:
+// |a ∘ e - a| <= epsilon and |e ∘ a - a| <= epsilon.
+//
+// Example:
+//
+//	func TestFloatMultiplicationIdentity(t *testing.T) {
+//	    mul := func(a, b float64) float64 { return a * b }
+//	    lawtest.IdentityApprox(t, mul, 1.0, lawtest.Float64Gen(-100, 100), 1e-12)
+//	}
+func IdentityApprox[T Approximate](t TB, op BinaryOp[T], identity T, gen Generator[T], epsilon float64) {
+	IdentityApproxWithConfig(t, op, identity, gen, epsilon, DefaultConfig())
+}
+
+// IdentityApproxWithConfig tests an approximate identity element with custom configuration.
+func IdentityApproxWithConfig[T Approximate](t TB, op BinaryOp[T], identity T, gen Generator[T], epsilon float64, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		// a ∘ e ≈ a
+		leftResult := op(a, identity)
+		if !ApproxEqual(leftResult, a, epsilon) {
+			return cfg.sprintf("Left identity failed: |a∘e - a| > %g\n  a=%v, e=%v, a∘e=%v, %s",
+				epsilon, a, identity, leftResult, approxFailure(leftResult, a))
+		}
+
+		// e ∘ a ≈ a
+		rightResult := op(identity, a)
+		if !ApproxEqual(rightResult, a, epsilon) {
+			return cfg.sprintf("Right identity failed: |e∘a - a| > %g\n  e=%v, a=%v, e∘a=%v, %s",
+				epsilon, identity, a, rightResult, approxFailure(rightResult, a))
+		}
+
+		return ""
+	})
+}
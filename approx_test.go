@@ -0,0 +1,61 @@
+package lawtest_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Test complex multiplication is commutative and associative within tolerance
+func TestComplexApprox(t *testing.T) {
+	gen := lawtest.ComplexGen(lawtest.Float64Gen(-10, 10), lawtest.Float64Gen(-10, 10))
+	mul := func(a, b complex128) complex128 { return a * b }
+
+	lawtest.CommutativeApprox(t, mul, gen, 1e-9)
+	lawtest.AssociativeApprox(t, mul, gen, 1e-9)
+}
+
+// Test float addition is associative within tolerance
+func TestFloatApprox(t *testing.T) {
+	type Celsius float64
+
+	add := func(a, b Celsius) Celsius { return a + b }
+	gen := func() Celsius { return Celsius(lawtest.Float64Gen(-100, 100)()) }
+
+	lawtest.AssociativeApprox(t, add, gen, 1e-9)
+	lawtest.CommutativeApprox(t, add, gen, 0)
+}
+
+// Test ApproxEqual on floats and complex numbers
+func TestApproxEqual(t *testing.T) {
+	if !lawtest.ApproxEqual(0.1+0.2, 0.3, 1e-12) {
+		t.Error("Expected 0.1+0.2 ≈ 0.3")
+	}
+	if lawtest.ApproxEqual(1.0, 1.1, 0.01) {
+		t.Error("Expected 1.0 and 1.1 to differ by more than 0.01")
+	}
+	if !lawtest.ApproxEqual(complex(3, 4), 0, 5) || lawtest.ApproxEqual(complex(3, 4), 0, 4.9) {
+		t.Error("Expected complex distance to be the modulus")
+	}
+}
+
+// Test IdentityApprox and the NaN and infinity edge cases
+func TestIdentityApprox(t *testing.T) {
+	mul := func(a, b float64) float64 { return a * b }
+	lawtest.IdentityApprox(t, mul, 1.0, lawtest.Float64Gen(-100, 100), 1e-12)
+
+	scale := func(a, b float64) float64 { return a * b * (1 + 1e-14) }
+	lawtest.IdentityApprox(t, scale, 1.0, lawtest.Float64Gen(-100, 100), 1e-9)
+
+	nan, inf := math.NaN(), math.Inf(1)
+	if lawtest.ApproxEqual(nan, nan, math.MaxFloat64) {
+		t.Error("Expected NaN to never be approximately equal")
+	}
+	if lawtest.ApproxEqual(complex(nan, 0), complex(nan, 0), math.MaxFloat64) {
+		t.Error("Expected complex NaN to never be approximately equal")
+	}
+	if !lawtest.ApproxEqual(inf, inf, 0) || lawtest.ApproxEqual(inf, -inf, math.MaxFloat64) {
+		t.Error("Expected equal infinities, and only those, to be approximately equal")
+	}
+}
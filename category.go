@@ -0,0 +1,376 @@
+package lawtest
+
+import "testing"
+
+// ===========================================================================
+// FUNCTOR LAWS
+// ===========================================================================
+
+// Go has no higher-kinded types, so a generic Map cannot be passed around as
+// a single value. The functor checks instead take one instantiation of Map
+// per element type involved. For a container with
+//
+//	func Map[A, B any](fa Box[A], f func(A) B) Box[B]
+//
+// pass Map[int, int], Map[int, string], and so on.
+
+// FunctorIdentity tests the functor identity law: map(fa, id) = fa.
+//
+// Containers are usually not comparable, so an equality function is required.
+//
+// Example:
+//
+//	func TestSliceFunctorIdentity(t *testing.T) {
+//	    gen := func() []int { return []int{rand.Intn(10), rand.Intn(10)} }
+//	    eq := func(a, b []int) bool { return reflect.DeepEqual(a, b) }
+//	    lawtest.FunctorIdentity(t, MapSlice[int, int], gen, eq)
+//	}
+func FunctorIdentity[F, A any](t TB, mapper func(F, func(A) A) F, gen Generator[F], eq func(F, F) bool) {
+	FunctorIdentityWithConfig(t, mapper, gen, eq, DefaultConfig())
+}
+
+// FunctorIdentityWithConfig tests the functor identity law with custom configuration.
+func FunctorIdentityWithConfig[F, A any](t TB, mapper func(F, func(A) A) F, gen Generator[F], eq func(F, F) bool, cfg *Config) {
+	t.Helper()
+
+	id := func(a A) A { return a }
+
+	checkCases(t, cfg, func(int) string {
+		fa := gen()
+
+		mapped := mapper(fa, id)
+		if !eq(mapped, fa) {
+			return cfg.sprintf("Functor identity failed: map(fa, id) != fa\n  fa=%v, map(fa, id)=%v",
+				fa, mapped)
+		}
+
+		return ""
+	})
+}
+
+// FunctorComposition tests the functor composition law:
+// map(fa, g∘h) = map(map(fa, h), g).
+//
+// mapAB, mapBC, and mapAC are the Map instantiations for h: A → B,
+// g: B → C, and g∘h: A → C respectively.
+//
+// Example:
+//
+//	func TestSliceFunctorComposition(t *testing.T) {
+//	    h := func(x int) string { return strconv.Itoa(x) }
+//	    g := func(s string) int { return len(s) }
+//	    lawtest.FunctorComposition(t,
+//	        MapSlice[int, string], MapSlice[string, int], MapSlice[int, int],
+//	        h, g, gen, eq)
+//	}
+func FunctorComposition[FA, FB, FC, A, B, C any](t TB,
+	mapAB func(FA, func(A) B) FB, mapBC func(FB, func(B) C) FC, mapAC func(FA, func(A) C) FC,
+	h func(A) B, g func(B) C, gen Generator[FA], eq func(FC, FC) bool) {
+	FunctorCompositionWithConfig(t, mapAB, mapBC, mapAC, h, g, gen, eq, DefaultConfig())
+}
+
+// FunctorCompositionWithConfig tests the functor composition law with custom configuration.
+func FunctorCompositionWithConfig[FA, FB, FC, A, B, C any](t TB,
+	mapAB func(FA, func(A) B) FB, mapBC func(FB, func(B) C) FC, mapAC func(FA, func(A) C) FC,
+	h func(A) B, g func(B) C, gen Generator[FA], eq func(FC, FC) bool, cfg *Config) {
+	t.Helper()
+
+	gh := func(a A) C { return g(h(a)) }
+
+	checkCases(t, cfg, func(int) string {
+		fa := gen()
+
+		// map(fa, g∘h)
+		left := mapAC(fa, gh)
+
+		// map(map(fa, h), g)
+		fb := mapAB(fa, h)
+		right := mapBC(fb, g)
+
+		if !eq(left, right) {
+			return cfg.sprintf("Functor composition failed: map(fa, g∘h) != map(map(fa, h), g)\n  fa=%v, map(fa, h)=%v\n  left=%v, right=%v",
+				fa, fb, left, right)
+		}
+
+		return ""
+	})
+}
+
+// TestFunctor verifies both functor laws for a mappable container.
+//
+// Tests performed:
+//   - Identity: map(fa, id) = fa
+//   - Composition: map(fa, g∘h) = map(map(fa, h), g)
+//
+// Example:
+//
+//	func TestOptionFunctor(t *testing.T) {
+//	    lawtest.TestFunctor(t,
+//	        MapOption[int, int],
+//	        MapOption[int, string], MapOption[string, int], MapOption[int, int],
+//	        strconv.Itoa, func(s string) int { return len(s) },
+//	        OptionGen(), OptionEqual[int], OptionEqual[int])
+//	}
+func TestFunctor[FA, FB, FC, A, B, C any](t *testing.T,
+	mapAA func(FA, func(A) A) FA,
+	mapAB func(FA, func(A) B) FB, mapBC func(FB, func(B) C) FC, mapAC func(FA, func(A) C) FC,
+	h func(A) B, g func(B) C, gen Generator[FA], eqA func(FA, FA) bool, eqC func(FC, FC) bool) {
+	TestFunctorWithConfig(t, mapAA, mapAB, mapBC, mapAC, h, g, gen, eqA, eqC, DefaultConfig())
+}
+
+// TestFunctorWithConfig verifies the functor laws with custom configuration.
+func TestFunctorWithConfig[FA, FB, FC, A, B, C any](t *testing.T,
+	mapAA func(FA, func(A) A) FA,
+	mapAB func(FA, func(A) B) FB, mapBC func(FB, func(B) C) FC, mapAC func(FA, func(A) C) FC,
+	h func(A) B, g func(B) C, gen Generator[FA], eqA func(FA, FA) bool, eqC func(FC, FC) bool, cfg *Config) {
+	t.Helper()
+
+	t.Run("Identity", func(t *testing.T) {
+		FunctorIdentityWithConfig(t, mapAA, gen, eqA, cfg)
+	})
+
+	t.Run("Composition", func(t *testing.T) {
+		FunctorCompositionWithConfig(t, mapAB, mapBC, mapAC, h, g, gen, eqC, cfg)
+	})
+}
+
+// ===========================================================================
+// MONAD LAWS
+// ===========================================================================
+
+// TestMonad verifies the three monad laws for unit (return) and bind (>>=).
+//
+// Tests performed:
+//   - Left identity: bind(unit(a), f) = f(a)
+//   - Right identity: bind(m, unit) = m
+//   - Associativity: bind(bind(m, f), g) = bind(m, λx. bind(f(x), g))
+//
+// As with functors, bind is taken for a single element type A. genF
+// produces Kleisli functions A → M used as f and g.
+//
+// Example:
+//
+//	func TestOptionMonad(t *testing.T) {
+//	    unit := func(a int) Option[int] { return Some(a) }
+//	    bind := func(m Option[int], f func(int) Option[int]) Option[int] {
+//	        if !m.Ok {
+//	            return m
+//	        }
+//	        return f(m.Value)
+//	    }
+//	    genF := func() func(int) Option[int] {
+//	        k := rand.Intn(10)
+//	        return func(a int) Option[int] { return Some(a + k) }
+//	    }
+//	    lawtest.TestMonad(t, unit, bind, lawtest.IntGen(-100, 100), OptionGen(), genF, OptionEqual)
+//	}
+func TestMonad[M, A any](t *testing.T, unit func(A) M, bind func(M, func(A) M) M,
+	genA Generator[A], genM Generator[M], genF Generator[func(A) M], eq func(M, M) bool) {
+	TestMonadWithConfig(t, unit, bind, genA, genM, genF, eq, DefaultConfig())
+}
+
+// TestMonadWithConfig verifies the monad laws with custom configuration.
+func TestMonadWithConfig[M, A any](t *testing.T, unit func(A) M, bind func(M, func(A) M) M,
+	genA Generator[A], genM Generator[M], genF Generator[func(A) M], eq func(M, M) bool, cfg *Config) {
+	t.Helper()
+
+	t.Run("LeftIdentity", func(t *testing.T) {
+		checkCases(t, cfg, func(int) string {
+			a, f := genA(), genF()
+
+			// bind(unit(a), f)
+			left := bind(unit(a), f)
+
+			// f(a)
+			right := f(a)
+
+			if !eq(left, right) {
+				return cfg.sprintf("Monad left identity failed: bind(unit(a), f) != f(a)\n  a=%v\n  bind(unit(a), f)=%v, f(a)=%v",
+					a, left, right)
+			}
+
+			return ""
+		})
+	})
+
+	t.Run("RightIdentity", func(t *testing.T) {
+		checkCases(t, cfg, func(int) string {
+			m := genM()
+
+			result := bind(m, unit)
+			if !eq(result, m) {
+				return cfg.sprintf("Monad right identity failed: bind(m, unit) != m\n  m=%v, bind(m, unit)=%v",
+					m, result)
+			}
+
+			return ""
+		})
+	})
+
+	t.Run("Associativity", func(t *testing.T) {
+		checkCases(t, cfg, func(int) string {
+			m, f, g := genM(), genF(), genF()
+
+			// bind(bind(m, f), g)
+			left := bind(bind(m, f), g)
+
+			// bind(m, λx. bind(f(x), g))
+			right := bind(m, func(x A) M { return bind(f(x), g) })
+
+			if !eq(left, right) {
+				return cfg.sprintf("Monad associativity failed: bind(bind(m, f), g) != bind(m, λx. bind(f(x), g))\n  m=%v\n  left=%v, right=%v",
+					m, left, right)
+			}
+
+			return ""
+		})
+	})
+}
+
+// ===========================================================================
+// ENDOMORPHISM MONOIDS
+// ===========================================================================
+
+// TestEndoMonoid verifies that functions T → T form a monoid under
+// composition, with the identity function as identity.
+//
+// Functions are not comparable, so two composites are equal when they agree
+// on inputs drawn from inputGen. (f∘g)(x) means f(g(x)).
+//
+// Tests performed:
+//   - Associativity: ((f∘g)∘h)(x) = (f∘(g∘h))(x)
+//   - Identity: (id∘f)(x) = f(x) = (f∘id)(x)
+//
+// Composition of pure functions always satisfies these laws, so a failure
+// means the generated functions are not pure: middleware that counts calls,
+// caches across requests or otherwise behaves differently depending on how
+// often or in which grouping it is invoked. To check your own composition,
+// such as a middleware chain builder, use TestEndoMonoidCompose.
+//
+// Example:
+//
+//	func TestMiddlewarePipeline(t *testing.T) {
+//	    gen := lawtest.OneOf(
+//	        func() lawtest.UnaryOp[Request] { return WithAuth },
+//	        func() lawtest.UnaryOp[Request] { return WithTrace },
+//	        func() lawtest.UnaryOp[Request] { return Normalize },
+//	    )
+//	    lawtest.TestEndoMonoid(t, gen, genRequest)
+//	}
+func TestEndoMonoid[T comparable](t *testing.T, gen Generator[UnaryOp[T]], inputGen Generator[T]) {
+	TestEndoMonoidWithConfig(t, gen, inputGen, DefaultConfig())
+}
+
+// TestEndoMonoidWithConfig verifies the endomorphism monoid laws with custom configuration.
+func TestEndoMonoidWithConfig[T comparable](t *testing.T, gen Generator[UnaryOp[T]], inputGen Generator[T], cfg *Config) {
+	t.Helper()
+
+	TestEndoMonoidComposeWithConfig(t, composeUnary[T], gen, inputGen, cfg)
+}
+
+// TestEndoMonoidCompose verifies that compose makes functions T → T a
+// monoid, with the identity function as identity. compose(f, g) must mean
+// "f after g", like f∘g.
+//
+// It runs ComposeAssociative and ComposeIdentity as subtests, so a chain
+// builder, decorator stack or router that composes handlers its own way is
+// checked against plain composition's laws.
+//
+// Example:
+//
+//	func TestMiddlewareChain(t *testing.T) {
+//	    chain := func(f, g lawtest.UnaryOp[Request]) lawtest.UnaryOp[Request] {
+//	        return Chain(f, g) // Must run g first, then f
+//	    }
+//	    lawtest.TestEndoMonoidCompose(t, chain, genMiddleware, genRequest)
+//	}
+func TestEndoMonoidCompose[T comparable](t *testing.T, compose func(f, g UnaryOp[T]) UnaryOp[T], gen Generator[UnaryOp[T]], inputGen Generator[T]) {
+	TestEndoMonoidComposeWithConfig(t, compose, gen, inputGen, DefaultConfig())
+}
+
+// TestEndoMonoidComposeWithConfig verifies the monoid laws of compose with custom configuration.
+func TestEndoMonoidComposeWithConfig[T comparable](t *testing.T, compose func(f, g UnaryOp[T]) UnaryOp[T], gen Generator[UnaryOp[T]], inputGen Generator[T], cfg *Config) {
+	t.Helper()
+
+	t.Run("Associativity", func(t *testing.T) {
+		ComposeAssociativeWithConfig(t, compose, gen, inputGen, cfg)
+	})
+
+	t.Run("Identity", func(t *testing.T) {
+		ComposeIdentityWithConfig(t, compose, gen, inputGen, cfg)
+	})
+}
+
+// composeUnary returns f∘g.
+func composeUnary[T any](f, g UnaryOp[T]) UnaryOp[T] {
+	return func(x T) T { return f(g(x)) }
+}
+
+// ComposeAssociative tests if compose is associative:
+// compose(compose(f, g), h)(x) = compose(f, compose(g, h))(x).
+//
+// Example:
+//
+//	lawtest.ComposeAssociative(t, Chain, genMiddleware, genRequest)
+func ComposeAssociative[T comparable](t TB, compose func(f, g UnaryOp[T]) UnaryOp[T], gen Generator[UnaryOp[T]], inputGen Generator[T]) {
+	ComposeAssociativeWithConfig(t, compose, gen, inputGen, DefaultConfig())
+}
+
+// ComposeAssociativeWithConfig tests associativity of compose with custom configuration.
+func ComposeAssociativeWithConfig[T comparable](t TB, compose func(f, g UnaryOp[T]) UnaryOp[T], gen Generator[UnaryOp[T]], inputGen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		f, g, h := gen(), gen(), gen()
+		x := inputGen()
+
+		// ((f∘g)∘h)(x)
+		left := compose(compose(f, g), h)(x)
+
+		// (f∘(g∘h))(x)
+		right := compose(f, compose(g, h))(x)
+
+		if left != right {
+			return cfg.sprintf("Composition associativity failed: ((f∘g)∘h)(x) != (f∘(g∘h))(x)\n  x=%v\n  left=%v, right=%v",
+				x, left, right)
+		}
+
+		return ""
+	})
+}
+
+// ComposeIdentity tests if the identity function is neutral for compose:
+// compose(id, f)(x) = f(x) = compose(f, id)(x).
+//
+// Example:
+//
+//	lawtest.ComposeIdentity(t, Chain, genMiddleware, genRequest)
+func ComposeIdentity[T comparable](t TB, compose func(f, g UnaryOp[T]) UnaryOp[T], gen Generator[UnaryOp[T]], inputGen Generator[T]) {
+	ComposeIdentityWithConfig(t, compose, gen, inputGen, DefaultConfig())
+}
+
+// ComposeIdentityWithConfig tests the identity of compose with custom configuration.
+func ComposeIdentityWithConfig[T comparable](t TB, compose func(f, g UnaryOp[T]) UnaryOp[T], gen Generator[UnaryOp[T]], inputGen Generator[T], cfg *Config) {
+	t.Helper()
+
+	id := func(x T) T { return x }
+
+	checkCases(t, cfg, func(int) string {
+		f := gen()
+		x := inputGen()
+
+		fx := f(x)
+
+		if left := compose(id, f)(x); left != fx {
+			return cfg.sprintf("Composition left identity failed: (id∘f)(x) != f(x)\n  x=%v, (id∘f)(x)=%v, f(x)=%v",
+				x, left, fx)
+		}
+
+		if right := compose(f, id)(x); right != fx {
+			return cfg.sprintf("Composition right identity failed: (f∘id)(x) != f(x)\n  x=%v, (f∘id)(x)=%v, f(x)=%v",
+				x, right, fx)
+		}
+
+		return ""
+	})
+}
@@ -0,0 +1,180 @@
+package lawtest_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// A minimal generic slice Map for functor tests
+func mapSlice[A, B any](as []A, f func(A) B) []B {
+	bs := make([]B, len(as))
+	for i, a := range as {
+		bs[i] = f(a)
+	}
+	return bs
+}
+
+// Optional value container
+type Option[T any] struct {
+	Value T
+	Ok    bool
+}
+
+func mapOption[A, B any](o Option[A], f func(A) B) Option[B] {
+	if !o.Ok {
+		return Option[B]{}
+	}
+	return Option[B]{Value: f(o.Value), Ok: true}
+}
+
+func TestFunctorLaws(t *testing.T) {
+	itoa := strconv.Itoa
+	length := func(s string) int { return len(s) }
+
+	t.Run("Slice", func(t *testing.T) {
+		gen := func() []int {
+			list := make([]int, rand.Intn(10))
+			for i := range list {
+				list[i] = rand.Intn(1000)
+			}
+			return list
+		}
+		eq := func(a, b []int) bool { return reflect.DeepEqual(a, b) }
+
+		lawtest.TestFunctor(t,
+			mapSlice[int, int],
+			mapSlice[int, string], mapSlice[string, int], mapSlice[int, int],
+			itoa, length, gen, eq, eq)
+	})
+
+	t.Run("Option", func(t *testing.T) {
+		gen := func() Option[int] {
+			if rand.Intn(4) == 0 {
+				return Option[int]{}
+			}
+			return Option[int]{Value: rand.Intn(1000), Ok: true}
+		}
+		eq := func(a, b Option[int]) bool { return a == b }
+
+		lawtest.TestFunctor(t,
+			mapOption[int, int],
+			mapOption[int, string], mapOption[string, int], mapOption[int, int],
+			itoa, length, gen, eq, eq)
+	})
+}
+
+func TestMonadLaws(t *testing.T) {
+	t.Run("Option", func(t *testing.T) {
+		unit := func(a int) Option[int] { return Option[int]{Value: a, Ok: true} }
+		bind := func(m Option[int], f func(int) Option[int]) Option[int] {
+			if !m.Ok {
+				return m
+			}
+			return f(m.Value)
+		}
+		genM := func() Option[int] {
+			if rand.Intn(4) == 0 {
+				return Option[int]{}
+			}
+			return unit(rand.Intn(100))
+		}
+		genF := func() func(int) Option[int] {
+			k := rand.Intn(10)
+			return func(a int) Option[int] {
+				if a%7 == 0 {
+					return Option[int]{}
+				}
+				return unit(a + k)
+			}
+		}
+		eq := func(a, b Option[int]) bool { return a == b }
+
+		lawtest.TestMonad(t, unit, bind, lawtest.IntGen(-100, 100), genM, genF, eq)
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		unit := func(a int) []int { return []int{a} }
+		bind := func(m []int, f func(int) []int) []int {
+			result := []int{}
+			for _, a := range m {
+				result = append(result, f(a)...)
+			}
+			return result
+		}
+		genM := func() []int {
+			list := make([]int, rand.Intn(5))
+			for i := range list {
+				list[i] = rand.Intn(100)
+			}
+			return list
+		}
+		genF := func() func(int) []int {
+			n := rand.Intn(3)
+			return func(a int) []int {
+				result := make([]int, n)
+				for i := range result {
+					result[i] = a + i
+				}
+				return result
+			}
+		}
+		eq := func(a, b []int) bool { return reflect.DeepEqual(a, b) }
+
+		lawtest.TestMonad(t, unit, bind, lawtest.IntGen(-100, 100), genM, genF, eq)
+	})
+}
+
+func TestEndoMonoidLaws(t *testing.T) {
+	t.Run("StringTransforms", func(t *testing.T) {
+		gen := lawtest.OneOf(
+			func() lawtest.UnaryOp[string] { return strings.ToUpper },
+			func() lawtest.UnaryOp[string] { return strings.TrimSpace },
+			func() lawtest.UnaryOp[string] { return func(s string) string { return s + "!" } },
+		)
+		lawtest.TestEndoMonoid(t, gen, lawtest.StringGen(8))
+	})
+
+	t.Run("AffineMaps", func(t *testing.T) {
+		gen := func() lawtest.UnaryOp[int] {
+			a, b := rand.Intn(5), rand.Intn(10)
+			return func(x int) int { return a*x + b }
+		}
+		lawtest.TestEndoMonoid(t, gen, lawtest.IntGen(-100, 100))
+	})
+}
+
+// Test that a composition that adds a step of its own is caught
+func TestEndoMonoidCompose(t *testing.T) {
+	gen := lawtest.OneOf(
+		func() lawtest.UnaryOp[int] { return func(x int) int { return 2 * x } },
+		func() lawtest.UnaryOp[int] { return func(x int) int { return x + 3 } },
+		func() lawtest.UnaryOp[int] { return func(x int) int { return -x } },
+	)
+	compose := func(f, g lawtest.UnaryOp[int]) lawtest.UnaryOp[int] {
+		return func(x int) int { return f(g(x)) }
+	}
+	lawtest.TestEndoMonoidCompose(t, compose, gen, lawtest.IntGen(-100, 100))
+
+	// Counts every composition as one more step, like a chain builder
+	// that appends a tracing hop per link
+	traced := func(f, g lawtest.UnaryOp[int]) lawtest.UnaryOp[int] {
+		return func(x int) int { return f(g(x)) + 1 }
+	}
+
+	log := &failureLog{}
+	lawtest.ComposeAssociative(log, traced, gen, lawtest.IntGen(-100, 100))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Composition associativity failed") {
+		t.Errorf("Expected the traced composition to fail associativity, got %q", log.errors)
+	}
+
+	log = &failureLog{}
+	lawtest.ComposeIdentity(log, traced, gen, lawtest.IntGen(-100, 100))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "identity failed") {
+		t.Errorf("Expected the traced composition to fail identity, got %q", log.errors)
+	}
+}
@@ -0,0 +1,77 @@
+package lawtest
+
+import "context"
+
+// ===========================================================================
+// CONTEXT CANCELLATION
+// ===========================================================================
+
+// withContext returns a copy of cfg whose runs stop when ctx is done.
+func withContext(ctx context.Context, cfg *Config) *Config {
+	bound := *cfg
+	bound.ctx = ctx
+	return &bound
+}
+
+// AssociativeWithContext tests associativity, stopping early when ctx is
+// canceled or its deadline passes.
+//
+// A canceled run fails the test, like one that exceeds Config.Timeout, and
+// reports the context's error along with how many cases completed. The case
+// in progress is abandoned rather than awaited, so a hung operation can't
+// hold up the surrounding suite. Config.Timeout still applies; whichever
+// limit is reached first stops the run.
+//
+// Example:
+//
+//	func TestMergeAssociative(t *testing.T) {
+//	    ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+//	    defer cancel()
+//	    lawtest.AssociativeWithContext(ctx, t, merge, gen, lawtest.DefaultConfig())
+//	}
+//	// Property test canceled: context deadline exceeded (37 of 100 cases completed)
+func AssociativeWithContext[T comparable](ctx context.Context, t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	CheckAssociative(op, gen, withContext(ctx, pinSeed(t, cfg))).report(t)
+}
+
+// CommutativeWithContext tests commutativity, stopping early when ctx is
+// done. See AssociativeWithContext.
+func CommutativeWithContext[T comparable](ctx context.Context, t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	CheckCommutative(op, gen, withContext(ctx, pinSeed(t, cfg))).report(t)
+}
+
+// IdentityWithContext tests an identity element, stopping early when ctx
+// is done. See AssociativeWithContext.
+func IdentityWithContext[T comparable](ctx context.Context, t TB, op BinaryOp[T], identity T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	CheckIdentity(op, identity, gen, withContext(ctx, pinSeed(t, cfg))).report(t)
+}
+
+// InverseWithContext tests inverses, stopping early when ctx is done. See
+// AssociativeWithContext.
+func InverseWithContext[T comparable](ctx context.Context, t TB, op BinaryOp[T], inv UnaryOp[T], identity T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	CheckInverse(op, inv, identity, gen, withContext(ctx, pinSeed(t, cfg))).report(t)
+}
+
+// IdempotentWithContext tests idempotence, stopping early when ctx is
+// done. See AssociativeWithContext.
+func IdempotentWithContext[T comparable](ctx context.Context, t TB, op UnaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	CheckIdempotent(op, gen, withContext(ctx, pinSeed(t, cfg))).report(t)
+}
+
+// ClosureWithContext tests closure, stopping early when ctx is done. See
+// AssociativeWithContext.
+func ClosureWithContext[T any](ctx context.Context, t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	CheckClosure(op, gen, withContext(ctx, pinSeed(t, cfg))).report(t)
+}
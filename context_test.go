@@ -0,0 +1,53 @@
+package lawtest_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Test the WithContext variants pass when the context outlives the run
+func TestWithContextCompletes(t *testing.T) {
+	ctx := context.Background()
+	add := func(a, b int) int { return a + b }
+	neg := func(a int) int { return -a }
+	abs := func(a int) int { return max(a, -a) }
+	gen := lawtest.IntGen(-100, 100)
+	cfg := lawtest.DefaultConfig()
+
+	lawtest.AssociativeWithContext(ctx, t, add, gen, cfg)
+	lawtest.CommutativeWithContext(ctx, t, add, gen, cfg)
+	lawtest.IdentityWithContext(ctx, t, add, 0, gen, cfg)
+	lawtest.InverseWithContext(ctx, t, add, neg, 0, gen, cfg)
+	lawtest.IdempotentWithContext(ctx, t, abs, gen, cfg)
+	lawtest.ClosureWithContext(ctx, t, add, gen, cfg)
+}
+
+// Test a deadline stops a slow run and reports its progress
+func TestWithContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	slowAdd := func(a, b int) int {
+		time.Sleep(10 * time.Millisecond)
+		return a + b
+	}
+
+	cfg := lawtest.DefaultConfig()
+	cfg.Timeout = 0
+
+	log := &failureLog{}
+	start := time.Now()
+	lawtest.AssociativeWithContext(ctx, log, slowAdd, lawtest.IntGen(-100, 100), cfg)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Cancellation took effect too late: %v", elapsed)
+	}
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Property test canceled: context deadline exceeded") ||
+		!strings.Contains(log.errors[0], "of 100 cases completed") {
+		t.Errorf("Expected a canceled run with its progress, got %q", log.errors)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"maps"
 	"testing"
 
 	"github.com/alexshd/lawtest"
@@ -114,6 +115,26 @@ func TestGoodCacheImmutability(t *testing.T) {
 	} else {
 		t.Log("✅ Result is new instance (immutable merge)")
 	}
+
+	// The same check as a property over random caches
+	t.Run("ImmutableOpDeep", func(t *testing.T) {
+		op := func(a, b *GoodCache) *GoodCache {
+			return a.Merge(b).(*GoodCache)
+		}
+		gen := func() *GoodCache {
+			cache := NewGoodCache()
+			cache.Set(lawtest.StringGen(1)(), lawtest.IntGen(0, 9)())
+			return cache
+		}
+		clone := func(c *GoodCache) *GoodCache {
+			return &GoodCache{data: maps.Clone(c.data)}
+		}
+		eq := func(a, b *GoodCache) bool {
+			return maps.Equal(a.data, b.data)
+		}
+
+		lawtest.ImmutableOpDeep(t, op, gen, clone, eq)
+	})
 }
 
 // TestBrokenCacheImmutability verifies that BrokenCache DOES mutate (demonstrates bug)
@@ -46,7 +46,11 @@ func TestReverseListEquivalence(t *testing.T) {
 		return reflect.DeepEqual(a, b)
 	}
 
-	lawtest.EquivalentCustom(t, ReverseList, ReverseListIterative, gen, eq)
+	// Long lists are abbreviated on failure, with the first diverging index called out
+	cfg := lawtest.DefaultConfig()
+	cfg.Formatter = lawtest.CompactFormatter
+
+	lawtest.EquivalentCustomWithConfig(t, ReverseList, ReverseListIterative, gen, eq, cfg)
 }
 
 // TestFibonacciEquivalence proves iterative Fibonacci is equivalent to recursive.
@@ -65,17 +69,11 @@ func TestFibonacciIterEquivalence(t *testing.T) {
 
 // TestPowerEquivalence proves tail recursive power is equivalent to standard recursion.
 func TestPowerEquivalence(t *testing.T) {
-	gen := func() struct{ base, exp int } {
-		return struct{ base, exp int }{
-			base: rand.Intn(5) + 1,
-			exp:  rand.Intn(10),
-		}
-	}
-
-	lawtest.Equivalent(t,
-		func(p struct{ base, exp int }) int { return Power(p.base, p.exp) },
-		func(p struct{ base, exp int }) int { return PowerTail(p.base, p.exp, 1) },
-		gen,
+	lawtest.Equivalent2(t,
+		Power,
+		func(base, exp int) int { return PowerTail(base, exp, 1) },
+		lawtest.IntGen(1, 5),
+		lawtest.IntGen(0, 9),
 	)
 }
 
@@ -113,3 +111,15 @@ func BenchmarkFibonacciIter(b *testing.B) {
 		FibonacciIter(50)
 	}
 }
+
+// BenchmarkFibonacciEquivalent proves the iterative Fibonacci matches the
+// naive recursion, then benchmarks both and logs the speedup.
+func BenchmarkFibonacciEquivalent(b *testing.B) {
+	gen := func() int { return rand.Intn(20) }
+
+	lawtest.BenchEquivalent(b,
+		func(n int) int { return Fibonacci(n) },
+		func(n int) int { return FibonacciIterative(n) },
+		gen,
+	)
+}
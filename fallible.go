@@ -0,0 +1,179 @@
+package lawtest
+
+// ===========================================================================
+// FALLIBLE OPERATIONS
+// ===========================================================================
+
+// minVerifiedShare is the fraction of cases a fallible property must
+// actually verify. Below it the operation errored so often that a passing
+// run would prove little, and the test fails instead.
+const minVerifiedShare = 0.25
+
+// FallibleOp is a binary operation that can fail, such as a merge that
+// rejects conflicting inputs.
+type FallibleOp[T any] func(a, b T) (T, error)
+
+// AssociativeErr tests associativity of a fallible operation on the cases
+// where it succeeds: (a ∘ b) ∘ c = a ∘ (b ∘ c).
+//
+// A case in which any of the four applications returns an error is skipped
+// rather than failed. Skipped cases are tallied and logged, and the test
+// fails if fewer than a quarter of the cases could be verified, since then
+// the generator mostly produces inputs the operation rejects.
+//
+// Example:
+//
+//	func TestMergeAssociative(t *testing.T) {
+//	    merge := func(a, b Config) (Config, error) { return a.Merge(b) }
+//	    lawtest.AssociativeErr(t, merge, genConfig)
+//	}
+func AssociativeErr[T comparable](t TB, op FallibleOp[T], gen Generator[T]) {
+	AssociativeErrWithConfig(t, op, gen, DefaultConfig())
+}
+
+// AssociativeErrWithConfig tests associativity of a fallible operation with custom configuration.
+func AssociativeErrWithConfig[T comparable](t TB, op FallibleOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkFallible(t, cfg, "Associativity", func() (string, error) {
+		a, b, c := gen(), gen(), gen()
+
+		// (a ∘ b) ∘ c
+		ab, err := op(a, b)
+		if err != nil {
+			return "", err
+		}
+		left, err := op(ab, c)
+		if err != nil {
+			return "", err
+		}
+
+		// a ∘ (b ∘ c)
+		bc, err := op(b, c)
+		if err != nil {
+			return "", err
+		}
+		right, err := op(a, bc)
+		if err != nil {
+			return "", err
+		}
+
+		if left != right {
+			return cfg.sprintf("Associativity failed: (a∘b)∘c != a∘(b∘c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
+				a, b, c, left, right), nil
+		}
+
+		return "", nil
+	})
+}
+
+// CommutativeErr tests commutativity of a fallible operation on the cases
+// where it succeeds: a ∘ b = b ∘ a.
+//
+// Errors are handled as in AssociativeErr.
+func CommutativeErr[T comparable](t TB, op FallibleOp[T], gen Generator[T]) {
+	CommutativeErrWithConfig(t, op, gen, DefaultConfig())
+}
+
+// CommutativeErrWithConfig tests commutativity of a fallible operation with custom configuration.
+func CommutativeErrWithConfig[T comparable](t TB, op FallibleOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkFallible(t, cfg, "Commutativity", func() (string, error) {
+		a, b := gen(), gen()
+
+		left, err := op(a, b)
+		if err != nil {
+			return "", err
+		}
+		right, err := op(b, a)
+		if err != nil {
+			return "", err
+		}
+
+		if left != right {
+			return cfg.sprintf("Commutativity failed: a∘b != b∘a\n  a=%v, b=%v\n  a∘b=%v, b∘a=%v",
+				a, b, left, right), nil
+		}
+
+		return "", nil
+	})
+}
+
+// IdentityErr tests an identity element of a fallible operation on the
+// cases where it succeeds: a ∘ e = a and e ∘ a = a.
+//
+// Errors are handled as in AssociativeErr.
+func IdentityErr[T comparable](t TB, op FallibleOp[T], identity T, gen Generator[T]) {
+	IdentityErrWithConfig(t, op, identity, gen, DefaultConfig())
+}
+
+// IdentityErrWithConfig tests an identity element of a fallible operation with custom configuration.
+func IdentityErrWithConfig[T comparable](t TB, op FallibleOp[T], identity T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkFallible(t, cfg, "Identity", func() (string, error) {
+		a := gen()
+
+		// a ∘ e = a
+		leftResult, err := op(a, identity)
+		if err != nil {
+			return "", err
+		}
+		if leftResult != a {
+			return cfg.sprintf("Left identity failed: a∘e != a\n  a=%v, e=%v, a∘e=%v",
+				a, identity, leftResult), nil
+		}
+
+		// e ∘ a = a
+		rightResult, err := op(identity, a)
+		if err != nil {
+			return "", err
+		}
+		if rightResult != a {
+			return cfg.sprintf("Right identity failed: e∘a != a\n  e=%v, a=%v, e∘a=%v",
+				identity, a, rightResult), nil
+		}
+
+		return "", nil
+	})
+}
+
+// checkFallible runs the case loop for a fallible property, skipping the
+// cases for which check returns an error, and reports the outcome to t.
+func checkFallible(t TB, cfg *Config, law string, check func() (string, error)) {
+	t.Helper()
+
+	cfg = pinSeed(t, cfg)
+	logIgnoredOptions(t, cfg)
+
+	var (
+		skipped  int
+		firstErr error
+	)
+	run := runSeeded(cfg, func(int) string {
+		msg, err := check()
+		if err != nil {
+			if skipped == 0 {
+				firstErr = err
+			}
+			skipped++
+			return ""
+		}
+		return msg
+	})
+
+	if !run.report(t, cfg) || skipped == 0 {
+		return
+	}
+
+	verified := cfg.TestCases - skipped
+	if float64(verified) < minVerifiedShare*float64(cfg.TestCases) {
+		t.Errorf("%s failed: only %d of %d cases verified, op returned an error for the rest\n  first error: %v\n  %s",
+			law, verified, cfg.TestCases, firstErr, run.reproduce())
+		return
+	}
+
+	t.Logf("lawtest: %d of %d cases skipped because op returned an error (first: %v)",
+		skipped, cfg.TestCases, firstErr)
+}
@@ -0,0 +1,54 @@
+package lawtest_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+var errOverflow = errors.New("overflow")
+
+// boundedAdd adds small integers and rejects sums outside [-100, 100]
+func boundedAdd(a, b int) (int, error) {
+	if s := a + b; s >= -100 && s <= 100 {
+		return s, nil
+	}
+	return 0, errOverflow
+}
+
+// Test fallible properties skip erroring cases and check the rest
+func TestFallibleLaws(t *testing.T) {
+	gen := lawtest.IntGen(-40, 40)
+
+	log := &logRecorder{}
+	lawtest.AssociativeErr(log, boundedAdd, gen)
+	lawtest.CommutativeErr(log, boundedAdd, gen)
+	lawtest.IdentityErr(log, boundedAdd, 0, gen)
+	if len(log.errors) != 0 {
+		t.Fatalf("Expected bounded addition to pass, got %q", log.errors)
+	}
+	for _, line := range log.logs {
+		if !strings.Contains(line, "skipped because op returned an error (first: overflow)") {
+			t.Errorf("Unexpected log line %q", line)
+		}
+	}
+
+	sub := func(a, b int) (int, error) { return a - b, nil }
+	log = &logRecorder{}
+	lawtest.AssociativeErr(log, sub, gen)
+	lawtest.CommutativeErr(log, sub, gen)
+	if len(log.errors) != 2 || len(log.logs) != 0 {
+		t.Errorf("Expected subtraction to fail both laws without skips, got %q", log.errors)
+	}
+}
+
+// Test that a mostly-failing operation is reported rather than passing vacuously
+func TestFallibleTooFewVerified(t *testing.T) {
+	log := &failureLog{}
+	lawtest.AssociativeErr(log, boundedAdd, lawtest.IntGen(90, 100))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Associativity failed: only 0 of 100 cases verified") {
+		t.Errorf("Expected too few verified cases to fail, got %q", log.errors)
+	}
+}
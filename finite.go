@@ -0,0 +1,608 @@
+package lawtest
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// ===========================================================================
+// POWERS
+// ===========================================================================
+
+// RepeatOp combines a with itself n times: a ∘ a ∘ … ∘ a, folding left.
+//
+// It needs no identity, so it works for semigroups. Use Power when n may
+// be zero.
+//
+// Panics if n < 1.
+func RepeatOp[T any](op BinaryOp[T], a T, n int) T {
+	if n < 1 {
+		panic(fmt.Sprintf("n (%d) must be >= 1", n))
+	}
+
+	next := powers(op, a, a)
+	power := a
+	for k := 1; k < n; k++ {
+		power = next()
+	}
+	return power
+}
+
+// Power folds op over n copies of base starting from identity:
+// ((e ∘ base) ∘ base) ∘ … ∘ base. Power(op, e, base, 0) is e.
+//
+// It is the naive reference to check fast implementations against, such as
+// exponentiation by squaring:
+//
+//	mul := func(a, b int) int { return a * b }
+//	lawtest.Equivalent(t,
+//	    func(n int) int { return lawtest.Power(mul, 1, 3, n) },
+//	    func(n int) int { return FastPow(3, n) },
+//	    lawtest.IntGen(0, 30),
+//	)
+//
+// Panics if n < 0.
+func Power[T any](op BinaryOp[T], identity, base T, n int) T {
+	if n < 0 {
+		panic(fmt.Sprintf("n (%d) must be >= 0", n))
+	}
+
+	next := powers(op, identity, base)
+	power := identity
+	for k := 0; k < n; k++ {
+		power = next()
+	}
+	return power
+}
+
+// powers returns a function yielding acc ∘ a, (acc ∘ a) ∘ a, … on
+// successive calls.
+func powers[T any](op BinaryOp[T], acc, a T) func() T {
+	return func() T {
+		acc = op(acc, a)
+		return acc
+	}
+}
+
+// ===========================================================================
+// FINITE GROUPS
+// ===========================================================================
+
+// maxElementOrder bounds the search in ElementOrder so that elements of
+// infinite order, or broken implementations, can't loop forever.
+const maxElementOrder = 1 << 16
+
+// ElementOrder returns the order of a in g: the smallest k > 0 such that
+// a ∘ a ∘ … ∘ a (k times) = e.
+//
+// Returns an error if no such k is found within 65536 iterations, which
+// happens for elements of infinite order (e.g. 1 in the integers under
+// addition) or for implementations whose Op never reaches the identity.
+//
+// Example:
+//
+//	g := IntAddMod12{}
+//	k, err := lawtest.ElementOrder[int](g, 8) // k = 3: 8+8+8 ≡ 0 (mod 12)
+func ElementOrder[T comparable](g Group[T], a T) (int, error) {
+	identity := g.Identity()
+
+	next := powers(g.Op, a, a)
+	power := a
+	for k := 1; k <= maxElementOrder; k++ {
+		if power == identity {
+			return k, nil
+		}
+		power = next()
+	}
+
+	return 0, fmt.Errorf("order of %v exceeds %d (element may have infinite order)", a, maxElementOrder)
+}
+
+// TestSubgroup verifies that elements form a subgroup of g.
+//
+// Tests performed:
+//   - Identity: e is in the subset
+//   - Inverses: a⁻¹ is in the subset for every member a
+//   - Closure: a ∘ b is in the subset for every pair of members
+//
+// All pairs are checked exhaustively, so the subset should be small.
+//
+// Example:
+//
+//	func TestEvenResidues(t *testing.T) {
+//	    lawtest.TestSubgroup[int](t, IntAddMod12{}, []int{0, 2, 4, 6, 8, 10})
+//	}
+func TestSubgroup[T comparable](t *testing.T, g Group[T], elements []T) {
+	t.Helper()
+
+	s := newSubset(elements)
+
+	t.Run("Identity", func(t *testing.T) {
+		if msg := s.missingIdentity(g); msg != "" {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("Inverses", func(t *testing.T) {
+		if msg := s.missingInverse(g); msg != "" {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("Closure", func(t *testing.T) {
+		if msg := s.escapingProduct(g); msg != "" {
+			t.Error(msg)
+		}
+	})
+}
+
+// subset is a candidate subgroup, with its elements in order and as a set.
+type subset[T comparable] struct {
+	elements []T
+	members  map[T]bool
+}
+
+func newSubset[T comparable](elements []T) subset[T] {
+	members := make(map[T]bool, len(elements))
+	for _, x := range elements {
+		members[x] = true
+	}
+	return subset[T]{elements: elements, members: members}
+}
+
+// missingIdentity describes the identity of g if it isn't a member, or
+// returns "".
+func (s subset[T]) missingIdentity(g Group[T]) string {
+	if e := g.Identity(); !s.members[e] {
+		return fmt.Sprintf("Subgroup missing identity\n  e=%v, elements=%v", e, s.elements)
+	}
+	return ""
+}
+
+// missingInverse describes the first member whose inverse in g isn't a
+// member, or returns "".
+func (s subset[T]) missingInverse(g Group[T]) string {
+	for _, a := range s.elements {
+		if aInv := g.Inverse(a); !s.members[aInv] {
+			return fmt.Sprintf("Subgroup missing inverse\n  a=%v, a⁻¹=%v", a, aInv)
+		}
+	}
+	return ""
+}
+
+// escapingProduct describes the first pair of members whose product in g
+// isn't a member, or returns "" if the subset is closed under g.Op.
+func (s subset[T]) escapingProduct(g Group[T]) string {
+	for _, a := range s.elements {
+		for _, b := range s.elements {
+			if ab := g.Op(a, b); !s.members[ab] {
+				return fmt.Sprintf("Subgroup not closed: a∘b escapes the subset\n  a=%v, b=%v, a∘b=%v", a, b, ab)
+			}
+		}
+	}
+	return ""
+}
+
+// TestTranslationBijective verifies that translation by every element is a
+// bijection of a finite domain.
+//
+// Tests performed, for every a in domain:
+//   - Left translation x ↦ a ∘ x is injective and hits every element
+//   - Right translation x ↦ x ∘ a is injective and hits every element
+//
+// In a group, a ∘ x = b always has the unique solution x = a⁻¹ ∘ b, so every
+// row and column of the operation table is a permutation of the group.
+// The domain should be the whole of a finite group (or a subgroup); all
+// len(domain)² products are computed.
+//
+// Example:
+//
+//	func TestClockTranslations(t *testing.T) {
+//	    domain := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+//	    lawtest.TestTranslationBijective[int](t, IntAddMod12{}, domain)
+//	}
+func TestTranslationBijective[T comparable](t *testing.T, g Group[T], domain []T) {
+	t.Helper()
+
+	t.Run("LeftTranslation", func(t *testing.T) {
+		if msg := translationsBijective(domain, g.Op); msg != "" {
+			t.Errorf("Left %s", msg)
+		}
+	})
+
+	t.Run("RightTranslation", func(t *testing.T) {
+		if msg := translationsBijective(domain, func(a, x T) T { return g.Op(x, a) }); msg != "" {
+			t.Errorf("Right %s", msg)
+		}
+	})
+}
+
+// translationsBijective describes the first a in domain whose translation
+// x ↦ translate(a, x) is not a bijection of domain, or returns "".
+func translationsBijective[T comparable](domain []T, translate func(a, x T) T) string {
+	for _, a := range domain {
+		if msg := translationBijective(domain, func(x T) T { return translate(a, x) }); msg != "" {
+			return fmt.Sprintf("translation by a=%v is not a bijection: %s", a, msg)
+		}
+	}
+	return ""
+}
+
+// TestOnlyTrivialIdempotent verifies that the identity is the only
+// idempotent element of g: a ∘ a = a implies a = e.
+//
+// In a group, cancelling a from a ∘ a = a ∘ e leaves a = e, so a second
+// idempotent element proves the structure is not a group, typically that
+// some element lacks an inverse. Elements are sampled from g.Gen, or all of
+// them are checked if g is a FiniteGroup.
+//
+// Example:
+//
+//	func TestClockIdempotents(t *testing.T) {
+//	    lawtest.TestOnlyTrivialIdempotent[int](t, IntAddMod12{})
+//	}
+func TestOnlyTrivialIdempotent[T comparable](t TB, g Group[T]) {
+	TestOnlyTrivialIdempotentWithConfig(t, g, DefaultConfig())
+}
+
+// TestOnlyTrivialIdempotentWithConfig verifies that only the identity is
+// idempotent, with custom configuration.
+func TestOnlyTrivialIdempotentWithConfig[T comparable](t TB, g Group[T], cfg *Config) {
+	t.Helper()
+
+	gen := Generator[T](g.Gen)
+	if f, ok := g.(FiniteGroup[T]); ok {
+		gen, cfg = domainGen(cfg, f.Domain(), 1)
+	}
+
+	identity := g.Identity()
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		if aa := g.Op(a, a); aa == a && a != identity {
+			return cfg.sprintf("Non-trivial idempotent: a∘a = a but a != e, so this is not a group\n  a=%v, e=%v",
+				a, identity)
+		}
+
+		return ""
+	})
+}
+
+// TestInverseInvolution verifies that inverting twice gives back the
+// original element: (a⁻¹)⁻¹ = a.
+//
+// It holds in every group, since a is itself an inverse of a⁻¹ and inverses
+// are unique. A failure points at an Inverse implementation with a sign or
+// modulo error at some edge, such as a = 0 in modular arithmetic. Elements
+// are sampled from g.Gen, or all of them are checked if g is a FiniteGroup.
+//
+// Example:
+//
+//	func TestClockInverse(t *testing.T) {
+//	    lawtest.TestInverseInvolution[int](t, IntAddMod12{})
+//	}
+func TestInverseInvolution[T comparable](t TB, g Group[T]) {
+	TestInverseInvolutionWithConfig(t, g, DefaultConfig())
+}
+
+// TestInverseInvolutionWithConfig verifies that inverse is an involution,
+// with custom configuration.
+func TestInverseInvolutionWithConfig[T comparable](t TB, g Group[T], cfg *Config) {
+	t.Helper()
+
+	gen := Generator[T](g.Gen)
+	if f, ok := g.(FiniteGroup[T]); ok {
+		gen, cfg = domainGen(cfg, f.Domain(), 1)
+	}
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		inv := g.Inverse(a)
+		if invInv := g.Inverse(inv); invInv != a {
+			return cfg.sprintf("Inverse involution failed: (a⁻¹)⁻¹ != a\n  a=%v, a⁻¹=%v, (a⁻¹)⁻¹=%v",
+				a, inv, invInv)
+		}
+
+		return ""
+	})
+}
+
+// translationBijective describes why translate is not a bijection of
+// domain, or returns "" if it is.
+func translationBijective[T comparable](domain []T, translate func(T) T) string {
+	preimage := make(map[T]T, len(domain))
+	for _, x := range domain {
+		image := translate(x)
+		if y, ok := preimage[image]; ok && y != x {
+			return fmt.Sprintf("not injective\n  x=%v, y=%v both map to %v", y, x, image)
+		}
+		preimage[image] = x
+	}
+
+	for _, b := range domain {
+		if _, ok := preimage[b]; !ok {
+			return fmt.Sprintf("not surjective\n  nothing maps to %v", b)
+		}
+	}
+
+	return ""
+}
+
+// CayleyTable returns the operation table of g over domain:
+// table[i][j] = domain[i] ∘ domain[j].
+//
+// The table of a finite group is a Latin square, which ValidateCayleyTable
+// checks. Printed, it is also a compact picture of the group to debug a
+// broken implementation with.
+//
+// Example:
+//
+//	domain := []int{0, 1, 2, 3}
+//	table := lawtest.CayleyTable[int](IntAddMod4{}, domain)
+//	// [[0 1 2 3] [1 2 3 0] [2 3 0 1] [3 0 1 2]]
+func CayleyTable[T comparable](g Group[T], domain []T) [][]T {
+	table := make([][]T, len(domain))
+	for i, a := range domain {
+		table[i] = make([]T, len(domain))
+		for j, b := range domain {
+			table[i][j] = g.Op(a, b)
+		}
+	}
+	return table
+}
+
+// ValidateCayleyTable checks that table is a Latin square over domain:
+// every row and every column is a permutation of domain.
+//
+// This is the structural form of the unique solvability of a ∘ x = b and
+// x ∘ a = b in a group, and a necessary condition for table to be a group's
+// operation table. Returns an error naming the first offending row or
+// column, nil if the table is a Latin square.
+//
+// Example:
+//
+//	table := lawtest.CayleyTable[int](g, domain)
+//	if err := lawtest.ValidateCayleyTable(table, domain); err != nil {
+//	    t.Errorf("%v\n%v", err, table)
+//	}
+func ValidateCayleyTable[T comparable](table [][]T, domain []T) error {
+	if len(table) != len(domain) {
+		return fmt.Errorf("table has %d rows, domain has %d elements", len(table), len(domain))
+	}
+
+	for i, row := range table {
+		if len(row) != len(domain) {
+			return fmt.Errorf("row %d (a=%v) has %d entries, domain has %d elements", i, domain[i], len(row), len(domain))
+		}
+		if msg := permutationOf(domain, row); msg != "" {
+			return fmt.Errorf("row %d (a=%v) is not a permutation of the domain: %s", i, domain[i], msg)
+		}
+	}
+
+	column := make([]T, len(domain))
+	for j := range domain {
+		for i, row := range table {
+			column[i] = row[j]
+		}
+		if msg := permutationOf(domain, column); msg != "" {
+			return fmt.Errorf("column %d (b=%v) is not a permutation of the domain: %s", j, domain[j], msg)
+		}
+	}
+
+	return nil
+}
+
+// permutationOf describes why values is not a permutation of domain, or
+// returns "" if it is. values must have the same length as domain.
+func permutationOf[T comparable](domain, values []T) string {
+	members := make(map[T]bool, len(domain))
+	for _, x := range domain {
+		members[x] = true
+	}
+
+	seen := make(map[T]bool, len(values))
+	for _, v := range values {
+		if !members[v] {
+			return fmt.Sprintf("%v is outside the domain", v)
+		}
+		if seen[v] {
+			return fmt.Sprintf("%v appears twice", v)
+		}
+		seen[v] = true
+	}
+
+	return ""
+}
+
+// ===========================================================================
+// EXHAUSTIVE CHECKING
+// ===========================================================================
+
+// FiniteGroup is a Group that can list all of its elements.
+//
+// TestGroup and CheckGroup check every law against every combination of
+// elements of a FiniteGroup instead of random samples, which proves the laws
+// rather than making them likely. Domain is called once per check. A law
+// with more than 100,000 combinations, such as associativity over more than
+// 46 elements, is checked on Config.TestCases random combinations of the
+// elements instead, since visiting them all would outrun Config.Timeout.
+//
+// Example:
+//
+//	func (g IntAddMod12) Domain() []int {
+//	    return []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+//	}
+type FiniteGroup[T comparable] interface {
+	Group[T]
+
+	// Domain returns every element of the group
+	Domain() []T
+}
+
+// Exhaustive verifies a binary operation on a small finite domain by
+// checking every combination of elements rather than random samples.
+//
+// Tests performed:
+//   - Associativity: (a ∘ b) ∘ c = a ∘ (b ∘ c) for all len(domain)³ triples
+//   - Commutativity: a ∘ b = b ∘ a for all len(domain)² pairs
+//   - Identity: some e in domain satisfies a ∘ e = e ∘ a = a for every a
+//
+// Use it for bool, small modular types and enums, where random sampling
+// wastes cases on repeats and may still miss the one bad combination.
+//
+// Example:
+//
+//	func TestXor(t *testing.T) {
+//	    xor := func(a, b bool) bool { return a != b }
+//	    lawtest.Exhaustive(t, xor, []bool{false, true})
+//	}
+func Exhaustive[T comparable](t *testing.T, op BinaryOp[T], domain []T) {
+	t.Helper()
+
+	cfg := exhaustiveConfig(DefaultConfig(), domain, 3)
+
+	t.Run("Associativity", func(t *testing.T) {
+		CheckAssociative(op, enumerate(domain, 3), cfg).report(t)
+	})
+
+	t.Run("Commutativity", func(t *testing.T) {
+		CheckCommutative(op, enumerate(domain, 2), exhaustiveConfig(cfg, domain, 2)).report(t)
+	})
+
+	t.Run("Identity", func(t *testing.T) {
+		e, ok := findIdentity(op, domain)
+		if !ok {
+			t.Errorf("Identity failed: no element e in the domain satisfies a∘e = e∘a = a\n  domain=%v", domain)
+			return
+		}
+		t.Logf("✓ Identity element is %v", e)
+	})
+}
+
+// maxExhaustiveCases bounds the number of tuples the laws of a FiniteGroup
+// are checked against one by one. Enumerating the triples of a larger
+// domain would outrun Config.Timeout, so its laws are checked on
+// Config.TestCases random tuples of its elements instead.
+const maxExhaustiveCases = 100_000
+
+// domainGen returns a Generator and Config that check a law taking arity
+// operands over the elements of domain: every arity-tuple in turn if there
+// are at most maxExhaustiveCases of them, and cfg.TestCases random tuples
+// otherwise. Either way, counterexamples are not shrunk, since shrinking
+// could leave the domain.
+func domainGen[T any](cfg *Config, domain []T, arity int) (Generator[T], *Config) {
+	if cases, ok := tupleCount(len(domain), arity); ok && cases <= maxExhaustiveCases {
+		return enumerate(domain, arity), exhaustiveConfig(cfg, domain, arity)
+	}
+
+	sampled := *cfg
+	sampled.Shrinker = nil
+	sampled.ShrinkFunc = nil
+	return func() T { return domain[defaultRand.Intn(len(domain))] }, &sampled
+}
+
+// exhaustiveConfig returns a copy of cfg that runs one case per arity-tuple
+// of domain. Operands are enumerated rather than drawn, so redrawing for
+// distinct operands, repeating operands with SelfOpProbability, and
+// shrinking out of the domain are disabled.
+//
+// Panics if the number of tuples overflows an int.
+func exhaustiveConfig[T any](cfg *Config, domain []T, arity int) *Config {
+	cases, ok := tupleCount(len(domain), arity)
+	if !ok {
+		panic(fmt.Sprintf("domain of %d elements has too many %d-tuples to enumerate", len(domain), arity))
+	}
+
+	exhaustive := *cfg
+	exhaustive.TestCases = cases
+	exhaustive.RequireDistinct = false
+	exhaustive.SelfOpProbability = 0
+	exhaustive.Shrinker = nil
+	exhaustive.ShrinkFunc = nil
+	return &exhaustive
+}
+
+// tupleCount returns n to the power arity, the number of arity-tuples of n
+// elements, and whether it fits in an int.
+func tupleCount(n, arity int) (int, bool) {
+	cases := 1
+	for i := 0; i < arity; i++ {
+		if n != 0 && cases > math.MaxInt/n {
+			return 0, false
+		}
+		cases *= n
+	}
+	return cases, true
+}
+
+// enumerate returns a Generator whose successive groups of arity draws are
+// every arity-tuple of domain in turn, like the digits of an odometer.
+func enumerate[T any](domain []T, arity int) Generator[T] {
+	if len(domain) == 0 {
+		panic("domain must not be empty")
+	}
+
+	digits := make([]int, arity)
+	pos := 0
+	return func() T {
+		v := domain[digits[pos]]
+		pos++
+		if pos == arity {
+			pos = 0
+			for i := arity - 1; i >= 0; i-- {
+				digits[i]++
+				if digits[i] < len(domain) {
+					break
+				}
+				digits[i] = 0
+			}
+		}
+		return v
+	}
+}
+
+// findIdentity returns the element of domain that is a two-sided identity
+// for op over the whole domain, if there is one.
+func findIdentity[T comparable](op BinaryOp[T], domain []T) (T, bool) {
+	for _, e := range domain {
+		isIdentity := true
+		for _, a := range domain {
+			if op(a, e) != a || op(e, a) != a {
+				isIdentity = false
+				break
+			}
+		}
+		if isIdentity {
+			return e, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// checkFiniteGroup checks every group law over every combination of the
+// elements of g, or over random combinations if there are too many (see
+// maxExhaustiveCases).
+func checkFiniteGroup[T comparable](g FiniteGroup[T], cfg *Config) GroupReport[T] {
+	domain := g.Domain()
+	identity := g.Identity()
+
+	var report GroupReport[T]
+
+	gen, lawCfg := domainGen(cfg, domain, 3)
+	report.Associativity = CheckAssociative(g.Op, gen, lawCfg)
+
+	gen, lawCfg = domainGen(cfg, domain, 1)
+	report.Identity = CheckIdentity(g.Op, identity, gen, lawCfg)
+
+	gen, lawCfg = domainGen(cfg, domain, 1)
+	report.Inverse = CheckInverse(g.Op, g.Inverse, identity, gen, lawCfg)
+
+	gen, lawCfg = domainGen(cfg, domain, 2)
+	report.Closure = CheckClosure(g.Op, gen, lawCfg)
+
+	return report
+}
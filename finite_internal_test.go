@@ -0,0 +1,61 @@
+package lawtest
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// addMod is addition modulo n
+type addMod struct{ n int }
+
+func (g addMod) Op(a, b int) int   { return (a + b) % g.n }
+func (g addMod) Identity() int     { return 0 }
+func (g addMod) Inverse(a int) int { return (g.n - a) % g.n }
+func (g addMod) Gen() int          { return rand.Intn(g.n) }
+
+// Test that a subset that isn't a subgroup is reported law by law
+func TestSubsetViolations(t *testing.T) {
+	g := addMod{n: 12}
+
+	even := newSubset([]int{0, 2, 4, 6, 8, 10})
+	if msg := even.missingIdentity(g) + even.missingInverse(g) + even.escapingProduct(g); msg != "" {
+		t.Errorf("Expected the even residues to be a subgroup, got %q", msg)
+	}
+
+	s := newSubset([]int{0, 1})
+	if msg := s.missingIdentity(g); msg != "" {
+		t.Errorf("Expected the identity to be found, got %q", msg)
+	}
+	if msg := s.missingInverse(g); !strings.Contains(msg, "a=1, a⁻¹=11") {
+		t.Errorf("Expected the missing inverse of 1, got %q", msg)
+	}
+	if msg := s.escapingProduct(g); !strings.Contains(msg, "a=1, b=1, a∘b=2") {
+		t.Errorf("Expected 1∘1 to escape the subset, got %q", msg)
+	}
+
+	if msg := newSubset([]int{4, 8}).missingIdentity(g); !strings.Contains(msg, "e=0") {
+		t.Errorf("Expected the missing identity to be reported, got %q", msg)
+	}
+}
+
+// Test that translations that collide or leave the domain are reported
+func TestTranslationViolations(t *testing.T) {
+	g := addMod{n: 12}
+	if msg := translationsBijective([]int{0, 3, 6, 9}, g.Op); msg != "" {
+		t.Errorf("Expected translations of a subgroup to be bijections, got %q", msg)
+	}
+
+	// Multiplication mod 4 sends everything to 0 when a=0
+	mulMod4 := func(a, x int) int { return a * x % 4 }
+	msg := translationsBijective([]int{0, 1, 2, 3}, mulMod4)
+	if !strings.Contains(msg, "translation by a=0 is not a bijection: not injective\n  x=0, y=1 both map to 0") {
+		t.Errorf("Expected the colliding pair to be reported, got %q", msg)
+	}
+
+	// {0, 1} is not closed, so translating by 1 misses 0
+	msg = translationsBijective([]int{0, 1}, g.Op)
+	if !strings.Contains(msg, "translation by a=1 is not a bijection: not surjective\n  nothing maps to 0") {
+		t.Errorf("Expected the missed element to be reported, got %q", msg)
+	}
+}
@@ -0,0 +1,281 @@
+package lawtest_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+func TestElementOrder(t *testing.T) {
+	g := IntModGroup{modulus: 12}
+
+	tests := []struct {
+		a, order int
+	}{
+		{0, 1},
+		{1, 12},
+		{2, 6},
+		{3, 4},
+		{4, 3},
+		{6, 2},
+		{8, 3},
+	}
+
+	for _, tc := range tests {
+		k, err := lawtest.ElementOrder[int](g, tc.a)
+		if err != nil {
+			t.Errorf("ElementOrder(%d): unexpected error: %v", tc.a, err)
+			continue
+		}
+		if k != tc.order {
+			t.Errorf("ElementOrder(%d) = %d, want %d", tc.a, k, tc.order)
+		}
+	}
+
+	t.Run("InfiniteOrder", func(t *testing.T) {
+		if _, err := lawtest.ElementOrder[int](IntAdditionGroup{}, 1); err == nil {
+			t.Error("Expected error for element of infinite order")
+		}
+	})
+}
+
+func TestSubgroups(t *testing.T) {
+	g := IntModGroup{modulus: 12}
+
+	t.Run("Even", func(t *testing.T) {
+		lawtest.TestSubgroup[int](t, g, []int{0, 2, 4, 6, 8, 10})
+	})
+
+	t.Run("MultiplesOfFour", func(t *testing.T) {
+		lawtest.TestSubgroup[int](t, g, []int{0, 4, 8})
+	})
+
+	t.Run("Trivial", func(t *testing.T) {
+		lawtest.TestSubgroup[int](t, g, []int{0})
+	})
+}
+
+// Test Power and RepeatOp against closed forms
+func TestPower(t *testing.T) {
+	mulOp := func(a, b int) int { return a * b }
+	addOp := func(a, b int) int { return a + b }
+
+	fastPow := func(base, n int) int {
+		result := 1
+		for n > 0 {
+			if n&1 == 1 {
+				result *= base
+			}
+			base *= base
+			n >>= 1
+		}
+		return result
+	}
+
+	lawtest.Equivalent(t,
+		func(n int) int { return lawtest.Power(mulOp, 1, 3, n) },
+		func(n int) int { return fastPow(3, n) },
+		lawtest.IntGen(0, 30),
+	)
+
+	if got := lawtest.Power(addOp, 0, 7, 0); got != 0 {
+		t.Errorf("Expected Power with n=0 to return the identity, got %d", got)
+	}
+	if got := lawtest.RepeatOp(addOp, 7, 5); got != 35 {
+		t.Errorf("Expected 7 repeated 5 times to be 35, got %d", got)
+	}
+	if got := lawtest.RepeatOp(func(a, b string) string { return a + b }, "ab", 3); got != "ababab" {
+		t.Errorf("Expected ababab, got %q", got)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for n < 1")
+			}
+		}()
+		lawtest.RepeatOp(addOp, 1, 0)
+	}()
+}
+
+func TestTranslationBijective(t *testing.T) {
+	domain := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	lawtest.TestTranslationBijective[int](t, IntModGroup{modulus: 12}, domain)
+
+	t.Run("Subgroup", func(t *testing.T) {
+		lawtest.TestTranslationBijective[int](t, IntModGroup{modulus: 12}, []int{0, 3, 6, 9})
+	})
+}
+
+// FiniteModGroup is integers mod n with the whole group as its Domain
+type FiniteModGroup struct{ IntModGroup }
+
+func (g FiniteModGroup) Domain() []int {
+	domain := make([]int, g.modulus)
+	for i := range domain {
+		domain[i] = i
+	}
+	return domain
+}
+
+// GlitchyMod12 is addition mod 12 with a single wrong entry in its table
+type GlitchyMod12 struct{ FiniteModGroup }
+
+func (g GlitchyMod12) Op(a, b int) int {
+	if a == 7 && b == 11 {
+		return 5
+	}
+	return g.FiniteModGroup.Op(a, b)
+}
+
+// Test exhaustive verification of small domains
+func TestExhaustive(t *testing.T) {
+	xor := func(a, b bool) bool { return a != b }
+	lawtest.Exhaustive(t, xor, []bool{false, true})
+
+	maxOp := func(a, b int) int { return max(a, b) }
+	lawtest.Exhaustive(t, maxOp, []int{0, 1, 2, 3, 4})
+}
+
+// Test that finite groups are checked over every combination of elements
+func TestFiniteGroupExhaustive(t *testing.T) {
+	g := FiniteModGroup{IntModGroup{modulus: 12}}
+	lawtest.TestGroup[int](t, g)
+
+	report := lawtest.CheckGroup[int](g, lawtest.DefaultConfig())
+	if report.Associativity.CasesRun != 12*12*12 || report.Inverse.CasesRun != 12 {
+		t.Errorf("Expected every triple and element to be checked, got %d and %d cases",
+			report.Associativity.CasesRun, report.Inverse.CasesRun)
+	}
+
+	// One wrong entry out of 144 is found every time
+	report = lawtest.CheckGroup[int](GlitchyMod12{g}, lawtest.DefaultConfig())
+	if report.Associativity.Passed {
+		t.Error("Expected the wrong table entry to break associativity")
+	}
+	if !report.Identity.Passed || !report.Inverse.Passed {
+		t.Errorf("Expected identity and inverse to hold, got %q and %q",
+			report.Identity.Message, report.Inverse.Message)
+	}
+}
+
+// Test that laws with too many combinations to enumerate are sampled
+func TestFiniteGroupTooLargeToEnumerate(t *testing.T) {
+	g := FiniteModGroup{IntModGroup{modulus: 400}} // 64,000,000 triples
+
+	report := lawtest.CheckGroup[int](g, lawtest.DefaultConfig())
+	if !report.Passed() {
+		t.Fatalf("Expected Z/400 to pass, got %q", report.Associativity.Message)
+	}
+	if report.Associativity.CasesRun != 100 || report.Inverse.CasesRun != 400 {
+		t.Errorf("Expected sampled triples and every element, got %d and %d cases",
+			report.Associativity.CasesRun, report.Inverse.CasesRun)
+	}
+}
+
+// Test that SelfOpProbability can't skip combinations of a finite group
+func TestFiniteGroupSelfOpProbability(t *testing.T) {
+	cfg := lawtest.DefaultConfig()
+	cfg.SelfOpProbability = 1 // Would turn every pair into a self-op a∘a
+
+	g := FiniteModGroup{IntModGroup{modulus: 12}}
+	lawtest.TestGroupWithConfig[int](t, g, cfg)
+
+	glitchy := GlitchyMod12{g}
+	if report := lawtest.CheckGroup[int](glitchy, cfg); report.Associativity.Passed || !report.Closure.Passed {
+		t.Errorf("Expected only the wrong table entry to be found, got %q", report.Associativity.Message)
+	}
+	if abelian, ex := lawtest.IsAbelian[int](glitchy, cfg); abelian {
+		t.Errorf("Expected 7∘11 != 11∘7 to be found, got %v", ex)
+	}
+}
+
+// Test that counterexamples of finite groups aren't shrunk out of the domain
+func TestFiniteGroupIgnoresShrinkFunc(t *testing.T) {
+	cfg := lawtest.DefaultConfig()
+	cfg.ShrinkFunc = func(v any) []any {
+		return []any{v.(int) + 12} // Same residue, outside the domain
+	}
+
+	glitchy := GlitchyMod12{FiniteModGroup{IntModGroup{modulus: 12}}}
+	report := lawtest.CheckGroup[int](glitchy, cfg)
+	if report.Associativity.Passed {
+		t.Fatal("Expected the wrong table entry to break associativity")
+	}
+	for _, v := range report.Associativity.Counterexample {
+		if v < 0 || v >= 12 {
+			t.Errorf("Expected a counterexample from the domain, got %v", report.Associativity.Counterexample)
+			break
+		}
+	}
+}
+
+// Test Cayley tables of finite groups are Latin squares
+func TestCayleyTable(t *testing.T) {
+	g := FiniteModGroup{IntModGroup{modulus: 4}}
+	domain := g.Domain()
+
+	table := lawtest.CayleyTable[int](g, domain)
+	want := [][]int{{0, 1, 2, 3}, {1, 2, 3, 0}, {2, 3, 0, 1}, {3, 0, 1, 2}}
+	if !reflect.DeepEqual(table, want) {
+		t.Fatalf("CayleyTable = %v, want %v", table, want)
+	}
+	if err := lawtest.ValidateCayleyTable(table, domain); err != nil {
+		t.Errorf("Expected a Latin square, got %v", err)
+	}
+
+	glitchy := GlitchyMod12{FiniteModGroup{IntModGroup{modulus: 12}}}
+	err := lawtest.ValidateCayleyTable(lawtest.CayleyTable[int](glitchy, glitchy.Domain()), glitchy.Domain())
+	if err == nil || !strings.Contains(err.Error(), "row 7 (a=7)") {
+		t.Errorf("Expected row 7 to repeat an element, got %v", err)
+	}
+
+	// Multiplication mod 4 is not a group: 0 annihilates its whole row
+	mulMod4 := [][]int{{0, 0, 0, 0}, {0, 1, 2, 3}, {0, 2, 0, 2}, {0, 3, 2, 1}}
+	if err := lawtest.ValidateCayleyTable(mulMod4, domain); err == nil {
+		t.Error("Expected multiplication mod 4 to fail")
+	}
+	if err := lawtest.ValidateCayleyTable(table[:3], domain); err == nil {
+		t.Error("Expected a missing row to fail")
+	}
+}
+
+// MaxMod12 takes the maximum instead of adding, so every element is
+// idempotent and 0 is still an identity
+type MaxMod12 struct{ FiniteModGroup }
+
+func (g MaxMod12) Op(a, b int) int { return max(a, b) }
+
+// Test that groups have no idempotents besides the identity
+func TestOnlyTrivialIdempotent(t *testing.T) {
+	lawtest.TestOnlyTrivialIdempotent[int](t, IntModGroup{modulus: 12})
+	lawtest.TestOnlyTrivialIdempotent[int](t, FiniteModGroup{IntModGroup{modulus: 7}})
+	lawtest.TestOnlyTrivialIdempotentWithConfig[int](t, IntAdditionGroup{}, lawtest.DefaultConfig())
+
+	log := &failureLog{}
+	lawtest.TestOnlyTrivialIdempotent[int](log, MaxMod12{FiniteModGroup{IntModGroup{modulus: 12}}})
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Non-trivial idempotent") || !strings.Contains(log.errors[0], "a=1, e=0") {
+		t.Errorf("Expected the first non-identity idempotent to be reported, got %q", log.errors)
+	}
+}
+
+// SignErrorMod12 subtracts the wrong way round in Inverse, so inverses of
+// nonzero elements come out negative
+type SignErrorMod12 struct{ FiniteModGroup }
+
+func (g SignErrorMod12) Inverse(a int) int { return (a - g.modulus) % g.modulus }
+
+// Test that inverting twice is the identity map in groups
+func TestInverseInvolution(t *testing.T) {
+	lawtest.TestInverseInvolution[int](t, IntModGroup{modulus: 12})
+	lawtest.TestInverseInvolution[int](t, FiniteModGroup{IntModGroup{modulus: 7}})
+	lawtest.TestInverseInvolutionWithConfig[int](t, IntAdditionGroup{}, lawtest.DefaultConfig())
+
+	log := &failureLog{}
+	lawtest.TestInverseInvolution[int](log, SignErrorMod12{FiniteModGroup{IntModGroup{modulus: 12}}})
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "a=1, a⁻¹=-11, (a⁻¹)⁻¹=-11") {
+		t.Errorf("Expected the first element not to come back, got %q", log.errors)
+	}
+}
@@ -0,0 +1,135 @@
+package lawtest
+
+import "sync"
+
+// ===========================================================================
+// FOLDS
+// ===========================================================================
+
+// FoldConsistent tests that folding a slice from the left and from the
+// right gives the same result:
+// ((e ∘ x₁) ∘ x₂) ∘ … ∘ xₙ = x₁ ∘ (x₂ ∘ (… ∘ (xₙ ∘ e))).
+//
+// This is the guarantee a parallel reduction relies on, since splitting the
+// slice regroups the operations arbitrarily. It can catch associativity
+// bugs that only show up on longer inputs.
+//
+// Example:
+//
+//	func TestSumFold(t *testing.T) {
+//	    add := func(a, b int) int { return a + b }
+//	    lawtest.FoldConsistent(t, add, 0, lawtest.IntGen(-100, 100), 16)
+//	}
+func FoldConsistent[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T], sliceLen int) {
+	FoldConsistentWithConfig(t, op, identity, gen, sliceLen, DefaultConfig())
+}
+
+// FoldConsistentWithConfig tests fold consistency with custom configuration.
+func FoldConsistentWithConfig[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T], sliceLen int, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		xs := make([]T, sliceLen)
+		for i := range xs {
+			xs[i] = gen()
+		}
+
+		left := foldLeft(op, identity, xs)
+		right := foldRight(op, identity, xs)
+
+		if left != right {
+			return cfg.sprintf("Fold consistency failed: foldl != foldr\n  xs=%v\n  foldl=%v, foldr=%v",
+				xs, left, right)
+		}
+
+		return ""
+	})
+}
+
+// maxChunkLen bounds the average chunk length in ParallelReduceEquivalent.
+const maxChunkLen = 8
+
+// ParallelReduceEquivalent tests that a fan-out/fan-in reduction matches a
+// sequential one.
+//
+// Each case generates a slice of random length, reduces it left to right,
+// then splits it into chunks pieces that are reduced on separate goroutines
+// and combined in order. Both results must match, which requires op to be
+// associative and safe to call concurrently. Run with -race to also catch
+// data races inside op.
+//
+// Example:
+//
+//	func TestHistogramMergeParallel(t *testing.T) {
+//	    lawtest.ParallelReduceEquivalent(t, MergeHistograms, EmptyHistogram(), HistogramGen(), 8)
+//	}
+//
+// Panics if chunks < 1.
+func ParallelReduceEquivalent[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T], chunks int) {
+	ParallelReduceEquivalentWithConfig(t, op, identity, gen, chunks, DefaultConfig())
+}
+
+// ParallelReduceEquivalentWithConfig tests parallel reduction with custom configuration.
+func ParallelReduceEquivalentWithConfig[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T], chunks int, cfg *Config) {
+	t.Helper()
+
+	if chunks < 1 {
+		panic(cfg.sprintf("chunks (%d) must be >= 1", chunks))
+	}
+
+	length := IntGen(0, chunks*maxChunkLen)
+
+	checkCases(t, cfg, func(int) string {
+		xs := make([]T, length())
+		for i := range xs {
+			xs[i] = gen()
+		}
+
+		sequential := foldLeft(op, identity, xs)
+		parallel := parallelReduce(op, identity, xs, chunks)
+
+		if sequential != parallel {
+			return cfg.sprintf("Parallel reduction failed: sequential != parallel (%d chunks)\n  xs=%v\n  sequential=%v, parallel=%v",
+				chunks, xs, sequential, parallel)
+		}
+
+		return ""
+	})
+}
+
+// parallelReduce splits xs into chunks contiguous pieces, folds each on its
+// own goroutine and folds the partial results in order.
+func parallelReduce[T any](op BinaryOp[T], identity T, xs []T, chunks int) T {
+	partials := make([]T, chunks)
+
+	var wg sync.WaitGroup
+	for c := 0; c < chunks; c++ {
+		lo, hi := c*len(xs)/chunks, (c+1)*len(xs)/chunks
+		wg.Add(1)
+		go func(c int, piece []T) {
+			defer wg.Done()
+			partials[c] = foldLeft(op, identity, piece)
+		}(c, xs[lo:hi])
+	}
+	wg.Wait()
+
+	return foldLeft(op, identity, partials)
+}
+
+// foldLeft computes ((e ∘ x₁) ∘ x₂) ∘ … ∘ xₙ.
+func foldLeft[T any](op BinaryOp[T], identity T, xs []T) T {
+	acc := identity
+	for _, x := range xs {
+		acc = op(acc, x)
+	}
+	return acc
+}
+
+// foldRight computes x₁ ∘ (x₂ ∘ (… ∘ (xₙ ∘ e))).
+func foldRight[T any](op BinaryOp[T], identity T, xs []T) T {
+	acc := identity
+	for i := len(xs) - 1; i >= 0; i-- {
+		acc = op(xs[i], acc)
+	}
+	return acc
+}
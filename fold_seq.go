@@ -0,0 +1,54 @@
+//go:build go1.23
+
+package lawtest
+
+import "iter"
+
+// ===========================================================================
+// ITERATOR FOLDS
+// ===========================================================================
+
+// FoldSeqConsistent tests that folding the values of an iterator from the
+// left and from the right gives the same result, like FoldConsistent does
+// for slices.
+//
+// Each case calls seqGen for a fresh sequence and drains it once, so
+// single-use iterators are fine; the values are then folded both ways. The
+// sequence must be finite, or the run stops at Config.Timeout. An empty
+// sequence folds to identity from either side, so it always passes. It
+// requires Go 1.23 for range-over-func.
+//
+// Example:
+//
+//	func TestTreeSumFold(t *testing.T) {
+//	    add := func(a, b int) int { return a + b }
+//	    trees := lawtest.TreeGen(lawtest.IntGen(-100, 100), 5)
+//	    lawtest.FoldSeqConsistent(t, add, 0, func() iter.Seq[int] {
+//	        return InOrder(trees()) // Yields the tree's values left to right
+//	    })
+//	}
+func FoldSeqConsistent[T comparable](t TB, op BinaryOp[T], identity T, seqGen func() iter.Seq[T]) {
+	FoldSeqConsistentWithConfig(t, op, identity, seqGen, DefaultConfig())
+}
+
+// FoldSeqConsistentWithConfig tests fold consistency over iterators with custom configuration.
+func FoldSeqConsistentWithConfig[T comparable](t TB, op BinaryOp[T], identity T, seqGen func() iter.Seq[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		var xs []T
+		for x := range seqGen() {
+			xs = append(xs, x)
+		}
+
+		left := foldLeft(op, identity, xs)
+		right := foldRight(op, identity, xs)
+
+		if left != right {
+			return cfg.sprintf("Fold consistency failed: foldl != foldr over the sequence\n  seq=%v\n  foldl=%v, foldr=%v",
+				xs, left, right)
+		}
+
+		return ""
+	})
+}
@@ -0,0 +1,48 @@
+//go:build go1.23
+
+package lawtest_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// inOrder yields the values of a tree from left to right
+func inOrder[T any](n *lawtest.Node[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var walk func(*lawtest.Node[T]) bool
+		walk = func(n *lawtest.Node[T]) bool {
+			return n == nil || walk(n.Left) && yield(n.Value) && walk(n.Right)
+		}
+		walk(n)
+	}
+}
+
+// Testing fold consistency over iterators
+func TestFoldSeqConsistent(t *testing.T) {
+	addOp := func(a, b int) int { return a + b }
+	concatOp := func(a, b string) string { return a + b }
+
+	trees := lawtest.TreeGen(lawtest.IntGen(-100, 100), 5)
+	lawtest.FoldSeqConsistent(t, addOp, 0, func() iter.Seq[int] { return inOrder(trees()) })
+
+	words := lawtest.SliceGen(lawtest.StringGen(2), 0, 8)
+	lawtest.FoldSeqConsistent(t, concatOp, "", func() iter.Seq[string] { return slices.Values(words()) })
+
+	// An empty sequence folds to the identity either way
+	empty := func() iter.Seq[int] { return func(func(int) bool) {} }
+	lawtest.FoldSeqConsistent(t, addOp, 0, empty)
+
+	// Subtraction is not associative, so the folds disagree
+	subOp := func(a, b int) int { return a - b }
+	log := &failureLog{}
+	lawtest.FoldSeqConsistent(log, subOp, 0, func() iter.Seq[int] {
+		return slices.Values(lawtest.SliceGen(lawtest.IntGen(1, 100), 3, 8)())
+	})
+	if len(log.errors) != 1 {
+		t.Errorf("Expected subtraction to fail fold consistency, got %q", log.errors)
+	}
+}
@@ -0,0 +1,41 @@
+package lawtest_test
+
+import (
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Testing fold consistency
+func TestFoldConsistent(t *testing.T) {
+	addOp := func(a, b int) int { return a + b }
+	concatOp := func(a, b string) string { return a + b }
+
+	lawtest.FoldConsistent(t, addOp, 0, lawtest.IntGen(-100, 100), 16)
+	lawtest.FoldConsistent(t, concatOp, "", lawtest.StringGen(2), 8)
+
+	// Averaging is commutative but not associative, so the folds disagree
+	avgOp := func(a, b float64) float64 { return (a + b) / 2 }
+	log := &failureLog{}
+	lawtest.FoldConsistent(log, avgOp, 0, lawtest.Float64Gen(0, 100), 8)
+	if len(log.errors) != 1 {
+		t.Errorf("Expected averaging to fail fold consistency, got %q", log.errors)
+	}
+}
+
+// Testing parallel reduction
+func TestParallelReduceEquivalent(t *testing.T) {
+	addOp := func(a, b int) int { return a + b }
+	concatOp := func(a, b string) string { return a + b }
+
+	lawtest.ParallelReduceEquivalent(t, addOp, 0, lawtest.IntGen(-100, 100), 4)
+	lawtest.ParallelReduceEquivalent(t, concatOp, "", lawtest.StringGen(1), 8)
+	lawtest.ParallelReduceEquivalent(t, addOp, 0, lawtest.IntGen(-100, 100), 1)
+
+	// Subtraction is not associative, so regrouping into chunks changes the result
+	log := &failureLog{}
+	lawtest.ParallelReduceEquivalent(log, func(a, b int) int { return a - b }, 0, lawtest.IntGen(1, 100), 4)
+	if len(log.errors) != 1 {
+		t.Errorf("Expected subtraction to fail parallel reduction, got %q", log.errors)
+	}
+}
@@ -0,0 +1,181 @@
+package lawtest
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ===========================================================================
+// FAILURE FORMATTING
+// ===========================================================================
+
+// compactLimit is the number of elements CompactFormatter shows before
+// summarizing the rest of a slice or map.
+const compactLimit = 8
+
+// sprintf formats a failure message like fmt.Sprintf, rendering every %v
+// operand with cfg.Formatter when one is set. Other verbs, such as the %d
+// of an iteration number, are left alone.
+func (c *Config) sprintf(format string, args ...any) string {
+	if c.Formatter == nil {
+		return fmt.Sprintf(format, args...)
+	}
+
+	wrapped := make([]any, len(args))
+	for i, arg := range args {
+		wrapped[i] = formatted{value: arg, format: c.Formatter}
+	}
+	return fmt.Sprintf(format, wrapped...)
+}
+
+// diff returns a "diff:" line locating the first difference between two
+// results, or "" when cfg has no Formatter or the results are not slices,
+// arrays or maps.
+func (c *Config) diff(left, right any) string {
+	if c.Formatter == nil {
+		return ""
+	}
+	if d := Diff(left, right); d != "" {
+		return "\n  diff: " + d
+	}
+	return ""
+}
+
+// formatted renders a failure message operand with a Config.Formatter.
+type formatted struct {
+	value  any
+	format func(any) string
+}
+
+// Format implements fmt.Formatter.
+func (f formatted) Format(s fmt.State, verb rune) {
+	if verb == 'v' && !s.Flag('+') && !s.Flag('#') {
+		io.WriteString(s, f.format(f.value))
+		return
+	}
+	fmt.Fprintf(s, fmt.FormatString(s, verb), f.value)
+}
+
+// CompactFormatter formats values for failure messages, abbreviating slices,
+// arrays and maps longer than eight elements to their first eight and a
+// count of the rest. Other values are formatted with %v.
+//
+// Setting it as Config.Formatter also adds a diff line to failures that
+// compare two results, pointing at the first element where they diverge,
+// which the abbreviation may otherwise hide.
+//
+// Example:
+//
+//	cfg := lawtest.DefaultConfig()
+//	cfg.Formatter = lawtest.CompactFormatter
+//	lawtest.EquivalentCustomWithConfig(t, ReverseList, reverseNaive, gen, slices.Equal[[]int], cfg)
+//	// f1(input)=[9 8 7 6 5 4 3 2 … (+92 more)]
+//	// f2(input)=[9 8 7 6 5 4 3 2 … (+92 more)]
+//	// diff: first difference at index 57: 42 != 41
+func CompactFormatter(v any) string {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Len() <= compactLimit {
+			break
+		}
+		elems := make([]string, compactLimit)
+		for i := range elems {
+			elems[i] = fmt.Sprint(rv.Index(i).Interface())
+		}
+		return fmt.Sprintf("[%s … (+%d more)]", strings.Join(elems, " "), rv.Len()-compactLimit)
+
+	case reflect.Map:
+		if rv.Len() <= compactLimit {
+			break
+		}
+		keys := sortedKeys(rv)
+		elems := make([]string, compactLimit)
+		for i := range elems {
+			elems[i] = fmt.Sprintf("%v:%v", keys[i].Interface(), rv.MapIndex(keys[i]).Interface())
+		}
+		return fmt.Sprintf("map[%s … (+%d more)]", strings.Join(elems, " "), rv.Len()-compactLimit)
+	}
+	return fmt.Sprint(v)
+}
+
+// Diff describes the first difference between two slices, arrays or maps,
+// comparing elements with reflect.DeepEqual. It returns "" if a and b are
+// equal, are of different types, or are not slices, arrays or maps.
+//
+// Map keys are visited in the order of their %v formatting, so the
+// difference reported is stable from run to run.
+//
+// Example:
+//
+//	lawtest.Diff([]int{1, 2, 3}, []int{1, 5, 3})
+//	// first difference at index 1: 2 != 5
+//	lawtest.Diff([]int{1, 2}, []int{1, 2, 3})
+//	// lengths differ (2 != 3), first extra element at index 2: 3
+//	lawtest.Diff(map[string]int{"a": 1}, map[string]int{})
+//	// key a: 1 != (missing)
+func Diff(a, b any) string {
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !ra.IsValid() || !rb.IsValid() || ra.Type() != rb.Type() {
+		return ""
+	}
+
+	switch ra.Kind() {
+	case reflect.Slice, reflect.Array:
+		n := min(ra.Len(), rb.Len())
+		for i := 0; i < n; i++ {
+			x, y := ra.Index(i).Interface(), rb.Index(i).Interface()
+			if !reflect.DeepEqual(x, y) {
+				return fmt.Sprintf("first difference at index %d: %v != %v", i, x, y)
+			}
+		}
+		switch {
+		case ra.Len() > n:
+			return fmt.Sprintf("lengths differ (%d != %d), first extra element at index %d: %v",
+				ra.Len(), rb.Len(), n, ra.Index(n).Interface())
+		case rb.Len() > n:
+			return fmt.Sprintf("lengths differ (%d != %d), first extra element at index %d: %v",
+				ra.Len(), rb.Len(), n, rb.Index(n).Interface())
+		}
+
+	case reflect.Map:
+		keys := sortedKeys(ra)
+		for _, k := range sortedKeys(rb) {
+			if !ra.MapIndex(k).IsValid() {
+				keys = append(keys, k)
+			}
+		}
+		sortValues(keys)
+
+		for _, k := range keys {
+			x, y := ra.MapIndex(k), rb.MapIndex(k)
+			switch {
+			case !y.IsValid():
+				return fmt.Sprintf("key %v: %v != (missing)", k.Interface(), x.Interface())
+			case !x.IsValid():
+				return fmt.Sprintf("key %v: (missing) != %v", k.Interface(), y.Interface())
+			case !reflect.DeepEqual(x.Interface(), y.Interface()):
+				return fmt.Sprintf("key %v: %v != %v", k.Interface(), x.Interface(), y.Interface())
+			}
+		}
+	}
+	return ""
+}
+
+// sortedKeys returns the keys of the map m in the order of their %v
+// formatting.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sortValues(keys)
+	return keys
+}
+
+// sortValues sorts values by their %v formatting.
+func sortValues(values []reflect.Value) {
+	sort.Slice(values, func(i, j int) bool {
+		return fmt.Sprint(values[i].Interface()) < fmt.Sprint(values[j].Interface())
+	})
+}
@@ -0,0 +1,106 @@
+package lawtest_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Test Diff locates the first difference in slices and maps
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b any
+		want string
+	}{
+		{"equal slices", []int{1, 2, 3}, []int{1, 2, 3}, ""},
+		{"differing element", []int{1, 2, 3}, []int{1, 5, 3}, "first difference at index 1: 2 != 5"},
+		{"longer right", []int{1, 2}, []int{1, 2, 3}, "lengths differ (2 != 3), first extra element at index 2: 3"},
+		{"longer left", []string{"a", "b"}, []string{"a"}, "lengths differ (2 != 1), first extra element at index 1: b"},
+		{"arrays", [3]int{1, 2, 3}, [3]int{1, 2, 4}, "first difference at index 2: 3 != 4"},
+		{"missing key", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}, "key b: 2 != (missing)"},
+		{"extra key", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, "key b: (missing) != 2"},
+		{"differing value", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 3, "b": 4}, "key a: 1 != 3"},
+		{"scalars", 1, 2, ""},
+		{"mismatched types", []int{1}, []string{"1"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := lawtest.Diff(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: Diff(%v, %v) = %q, want %q", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// Test CompactFormatter abbreviates only long slices and maps
+func TestCompactFormatter(t *testing.T) {
+	long := make([]int, 20)
+	for i := range long {
+		long[i] = i
+	}
+	m := map[int]string{}
+	for i := 0; i < 10; i++ {
+		m[i] = "x"
+	}
+
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{42, "42"},
+		{[]int{1, 2, 3}, "[1 2 3]"},
+		{long, "[0 1 2 3 4 5 6 7 … (+12 more)]"},
+		{m, "map[0:x 1:x 2:x 3:x 4:x 5:x 6:x 7:x … (+2 more)]"},
+	}
+
+	for _, tt := range tests {
+		if got := lawtest.CompactFormatter(tt.v); got != tt.want {
+			t.Errorf("CompactFormatter(%v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+// Test a Formatter is applied to failure messages and adds a diff line
+func TestFormatterInFailures(t *testing.T) {
+	reverse := func(s []int) []int {
+		out := make([]int, len(s))
+		for i, v := range s {
+			out[len(s)-1-i] = v
+		}
+		return out
+	}
+	// Corrupts the eleventh element of long lists
+	buggy := func(s []int) []int {
+		out := reverse(s)
+		if len(out) > 10 {
+			out[10] = -1
+		}
+		return out
+	}
+	gen := lawtest.SliceGen(lawtest.IntGen(0, 99), 20, 20)
+
+	log := &failureLog{}
+	lawtest.EquivalentCustom(log, reverse, buggy, gen, slices.Equal[[]int])
+	if len(log.errors) != 1 || strings.Contains(log.errors[0], "diff:") || strings.Contains(log.errors[0], "more)") {
+		t.Errorf("Expected the default message to be unchanged, got %q", log.errors)
+	}
+
+	cfg := lawtest.DefaultConfig()
+	cfg.Formatter = lawtest.CompactFormatter
+	log = &failureLog{}
+	lawtest.EquivalentCustomWithConfig(log, reverse, buggy, gen, slices.Equal[[]int], cfg)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "(+12 more)]") ||
+		!strings.Contains(log.errors[0], "diff: first difference at index 10:") ||
+		!strings.Contains(log.errors[0], "Functions not equivalent at iteration 0") {
+		t.Errorf("Expected a compact message with a diff line, got %q", log.errors)
+	}
+
+	cfg.Formatter = func(v any) string { return "<value>" }
+	log = &failureLog{}
+	lawtest.AssociativeWithConfig(log, func(a, b int) int { return a - b }, lawtest.IntGen(1, 100), cfg)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "a=<value>, b=<value>, c=<value>") {
+		t.Errorf("Expected the formatter to render every operand, got %q", log.errors)
+	}
+}
@@ -0,0 +1,78 @@
+package lawtest
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ===========================================================================
+// FUZZ CORPUS EXPORT
+// ===========================================================================
+
+// writeFuzzCorpus saves args as a seed corpus entry in dir, in the format
+// go test -fuzz reads from testdata/fuzz/<FuzzTestName>.
+//
+// A fuzz target seeded this way takes one parameter per argument, in
+// order. Arguments of a type the fuzzer does not support are not written;
+// the skip is logged instead.
+//
+// Only Associative, Commutative, Identity, Inverse, Idempotent and Closure
+// keep their counterexamples as values, so only they save them. Other
+// properties log that Config.FuzzCorpusDir was ignored.
+func writeFuzzCorpus[T any](t TB, dir string, args []T) {
+	t.Helper()
+
+	entry, err := fuzzCorpusEntry(args)
+	if err != nil {
+		t.Logf("lawtest: fuzz corpus entry skipped: %v", err)
+		return
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Logf("lawtest: fuzz corpus entry skipped: %v", err)
+		return
+	}
+
+	// Name the file after its contents like go test does, so the same
+	// counterexample found twice is stored once.
+	path := filepath.Join(dir, fmt.Sprintf("%x", sha256.Sum256(entry))[:16])
+	if err := os.WriteFile(path, entry, 0o644); err != nil {
+		t.Logf("lawtest: fuzz corpus entry skipped: %v", err)
+		return
+	}
+
+	t.Logf("lawtest: counterexample saved to fuzz corpus %s", path)
+}
+
+// fuzzCorpusEntry encodes args in the "go test fuzz v1" corpus file format.
+func fuzzCorpusEntry[T any](args []T) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("go test fuzz v1\n")
+
+	for _, arg := range args {
+		switch v := any(arg).(type) {
+		case int:
+			fmt.Fprintf(&b, "int(%d)\n", v)
+		case string:
+			fmt.Fprintf(&b, "string(%q)\n", v)
+		case []byte:
+			fmt.Fprintf(&b, "[]byte(%q)\n", v)
+		case bool:
+			fmt.Fprintf(&b, "bool(%t)\n", v)
+		case float64:
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				fmt.Fprintf(&b, "math.Float64frombits(0x%x)\n", math.Float64bits(v))
+			} else {
+				fmt.Fprintf(&b, "float64(%v)\n", v)
+			}
+		default:
+			return nil, fmt.Errorf("%T is not a supported fuzz type (int, string, []byte, bool, float64)", arg)
+		}
+	}
+
+	return []byte(b.String()), nil
+}
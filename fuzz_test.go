@@ -0,0 +1,76 @@
+package lawtest
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFuzzCorpusEntry(t *testing.T) {
+	entry, err := fuzzCorpusEntry([]any{-3, "a\"b", []byte{0, 'x'}, true, 1.5, math.Inf(-1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "go test fuzz v1\n" +
+		"int(-3)\n" +
+		"string(\"a\\\"b\")\n" +
+		"[]byte(\"\\x00x\")\n" +
+		"bool(true)\n" +
+		"float64(1.5)\n" +
+		"math.Float64frombits(0xfff0000000000000)\n"
+	if string(entry) != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, entry)
+	}
+
+	if _, err := fuzzCorpusEntry([]struct{}{{}}); err == nil {
+		t.Error("Expected an error for an unsupported type")
+	}
+}
+
+func TestFuzzCorpusDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "testdata", "fuzz", "FuzzSub")
+	cfg := DefaultConfig()
+	cfg.FuzzCorpusDir = dir
+
+	sub := func(a, b int) int { return a - b }
+	res := CheckAssociative(sub, IntGen(-100, 100), cfg)
+	res.report(&recorder{})
+
+	files, err := os.ReadDir(dir)
+	if err != nil || len(files) != 1 {
+		t.Fatalf("Expected one corpus file, got %v (%v)", files, err)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 4 || lines[0] != "go test fuzz v1" {
+		t.Errorf("Expected a header and three int arguments, got %q", data)
+	}
+
+	// Unsupported types are skipped without failing
+	type point struct{ x int }
+	skipped := DefaultConfig()
+	skipped.FuzzCorpusDir = filepath.Join(t.TempDir(), "skipped")
+	first := func(a, b point) point { return a }
+	CheckCommutative(first, func() point { return point{IntGen(0, 9)()} }, skipped).report(&recorder{})
+	if _, err := os.Stat(skipped.FuzzCorpusDir); !os.IsNotExist(err) {
+		t.Errorf("Expected no corpus directory for unsupported types, got %v", err)
+	}
+}
+
+func TestFuzzCorpusDirIgnored(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.FuzzCorpusDir = filepath.Join(t.TempDir(), "ignored")
+
+	log := &logRecorder{}
+	double := func(n int) int { return 2 * n }
+	EquivalentCustomWithConfig(log, double, func(n int) int { return n + 1 }, IntGen(-100, 100), func(a, b int) bool { return a == b }, cfg)
+
+	if len(log.logs) == 0 || !strings.Contains(log.logs[0], "Config.FuzzCorpusDir is ignored by this property") {
+		t.Errorf("Expected EquivalentCustom to log that it ignored the corpus directory, got %q", log.logs)
+	}
+	if _, err := os.Stat(cfg.FuzzCorpusDir); !os.IsNotExist(err) {
+		t.Errorf("Expected no corpus directory, got %v", err)
+	}
+}
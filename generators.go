@@ -0,0 +1,1034 @@
+package lawtest
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// ===========================================================================
+// GENERATOR COMBINATORS
+// ===========================================================================
+
+// Label tags a generator so every value it produces during a run under cfg
+// is attributed to name.
+//
+// Labels make it possible to confirm that each source of a combined
+// generator, such as one built with OneOf, actually contributed inputs to a
+// run, and how the cases drawing from it fared. The tallies are kept in cfg,
+// cleared at the start of each run, and returned in Result.Stats.Sources,
+// and logged with the other statistics when Config.Verbose is set. Runs
+// that share a Config share its tallies, so give tests running in parallel
+// a Config of their own.
+//
+// Example:
+//
+//	cfg := lawtest.DefaultConfig()
+//	small := lawtest.IntGen(-10, 10).Label(cfg, "small")
+//	large := lawtest.IntGen(-1e6, 1e6).Label(cfg, "large")
+//	res := lawtest.CheckAssociative(add, lawtest.OneOf(small, large), cfg)
+//	fmt.Println(res.Stats)
+//	// lawtest: 100 cases, 300 inputs, range [-998127, 995212]
+//	//   sources: large 152 values in 87 cases (87 passed), small 148 values in 85 cases (85 passed)
+func (g Generator[T]) Label(cfg *Config, name string) Generator[T] {
+	if cfg.sources == nil {
+		cfg.sources = &sourceTally{}
+	}
+	tally := cfg.sources
+
+	return func() T {
+		v := g()
+		tally.record(name)
+		return v
+	}
+}
+
+// SliceGen creates a Generator that produces slices with a random length in
+// [minLen, maxLen] and elements drawn from elem.
+//
+// Example:
+//
+//	gen := lawtest.SliceGen(lawtest.IntGen(-100, 100), 0, 20)
+//	lawtest.EquivalentCustom(t, SortFast, SortSimple, gen, slices.Equal[[]int])
+//
+// Panics if minLen < 0 or minLen > maxLen.
+func SliceGen[T any](elem Generator[T], minLen, maxLen int) Generator[[]T] {
+	if minLen < 0 {
+		panic(fmt.Sprintf("minLen (%d) must be >= 0", minLen))
+	}
+	if minLen > maxLen {
+		panic(fmt.Sprintf("minLen (%d) must be <= maxLen (%d)", minLen, maxLen))
+	}
+	length := IntGen(minLen, maxLen)
+
+	return func() []T {
+		s := make([]T, length())
+		for i := range s {
+			s[i] = elem()
+		}
+		return s
+	}
+}
+
+// SortedSliceGen is like SliceGen but sorts every slice in ascending
+// order.
+//
+// Use it for operations that are only defined, or only interesting, on
+// sorted input, and check their output with IsSorted:
+//
+//	gen := lawtest.SortedSliceGen(lawtest.IntGen(0, 100), 0, 20)
+//	lawtest.AssociativeCustom(t, MergeSorted, gen, slices.Equal[[]int])
+//
+// Panics if minLen < 0 or minLen > maxLen.
+func SortedSliceGen[T cmp.Ordered](elem Generator[T], minLen, maxLen int) Generator[[]T] {
+	gen := SliceGen(elem, minLen, maxLen)
+
+	return func() []T {
+		s := gen()
+		slices.Sort(s)
+		return s
+	}
+}
+
+// IsSorted reports whether s is sorted in ascending order.
+//
+// It is a postcondition helper for SortedSliceGen-based properties:
+//
+//	merged := MergeSorted(a, b)
+//	if !lawtest.IsSorted(merged) {
+//	    t.Errorf("merge produced unsorted output %v", merged)
+//	}
+func IsSorted[T cmp.Ordered](s []T) bool {
+	return slices.IsSorted(s)
+}
+
+// MapGen creates a Generator that produces maps with keys from keyGen and
+// values from valGen.
+//
+// Each map is built from a random number of entries in [minLen, maxLen].
+// Colliding keys overwrite each other, so the resulting map may be smaller
+// than the number of entries drawn.
+//
+// Example:
+//
+//	gen := lawtest.MapGen(lawtest.StringGen(3), lawtest.IntGen(0, 100), 0, 10)
+//	lawtest.AssociativeCustom(t, MergeCounts, gen, maps.Equal[map[string]int])
+//
+// Panics if minLen < 0 or minLen > maxLen.
+func MapGen[K comparable, V any](keyGen Generator[K], valGen Generator[V], minLen, maxLen int) Generator[map[K]V] {
+	if minLen < 0 {
+		panic(fmt.Sprintf("minLen (%d) must be >= 0", minLen))
+	}
+	if minLen > maxLen {
+		panic(fmt.Sprintf("minLen (%d) must be <= maxLen (%d)", minLen, maxLen))
+	}
+	length := IntGen(minLen, maxLen)
+
+	return func() map[K]V {
+		n := length()
+		m := make(map[K]V, n)
+		for i := 0; i < n; i++ {
+			m[keyGen()] = valGen()
+		}
+		return m
+	}
+}
+
+// Pair holds two values of possibly different types.
+//
+// It is the element type of PairGen, so properties over two inputs can use
+// the single-input functions. Pair is comparable whenever A and B are, and
+// prints as (a, b) in failure messages.
+type Pair[A, B any] struct {
+	A A
+	B B
+}
+
+// String formats the pair as (a, b).
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.A, p.B)
+}
+
+// Triple holds three values of possibly different types; see Pair.
+type Triple[A, B, C any] struct {
+	A A
+	B B
+	C C
+}
+
+// String formats the triple as (a, b, c).
+func (p Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", p.A, p.B, p.C)
+}
+
+// PairGen creates a Generator of pairs with components drawn from ga and gb.
+//
+// Example:
+//
+//	gen := lawtest.PairGen(lawtest.IntGen(0, 10), lawtest.IntGen(0, 20))
+//	lawtest.Equivalent(t,
+//	    func(p lawtest.Pair[int, int]) int { return Power(p.A, p.B) },
+//	    func(p lawtest.Pair[int, int]) int { return PowerTail(p.A, p.B, 1) },
+//	    gen)
+//	// Functions not equivalent at iteration 4
+//	//   input=(3, 7) ...
+func PairGen[A, B any](ga Generator[A], gb Generator[B]) Generator[Pair[A, B]] {
+	return func() Pair[A, B] {
+		return Pair[A, B]{ga(), gb()}
+	}
+}
+
+// TripleGen creates a Generator of triples with components drawn from ga,
+// gb and gc.
+func TripleGen[A, B, C any](ga Generator[A], gb Generator[B], gc Generator[C]) Generator[Triple[A, B, C]] {
+	return func() Triple[A, B, C] {
+		return Triple[A, B, C]{ga(), gb(), gc()}
+	}
+}
+
+// Map derives a generator that applies f to every value g produces.
+//
+// Example:
+//
+//	evens := lawtest.Map(lawtest.IntGen(-50, 50), func(n int) int { return 2 * n })
+func Map[T, U any](g Generator[T], f func(T) U) Generator[U] {
+	return func() U {
+		return f(g())
+	}
+}
+
+// Filter derives a generator that only produces values satisfying pred.
+//
+// Each call draws from g until pred holds, giving up after maxTries draws.
+// Prefer Map when the wanted values are rare: a filter that rejects most
+// values slows every test down.
+//
+// Example:
+//
+//	positive := lawtest.Filter(lawtest.IntGen(-100, 100), func(n int) bool { return n > 0 }, 100)
+//
+// Panics if maxTries < 1, or when a call finds no value within maxTries
+// draws.
+func Filter[T any](g Generator[T], pred func(T) bool, maxTries int) Generator[T] {
+	if maxTries < 1 {
+		panic(fmt.Sprintf("maxTries (%d) must be >= 1", maxTries))
+	}
+
+	return func() T {
+		for i := 0; i < maxTries; i++ {
+			if v := g(); pred(v) {
+				return v
+			}
+		}
+		panic(fmt.Sprintf("Filter: no value satisfied the predicate in %d tries", maxTries))
+	}
+}
+
+// OneOf creates a Generator that picks one of gens uniformly at random on
+// every call and returns its value.
+//
+// This is the natural way to cover every variant of a sum type:
+//
+//	gen := lawtest.OneOf(CircleGen(), SquareGen(), TriangleGen())
+//	lawtest.Idempotent(t, Normalize, gen)
+//
+// Panics if gens is empty.
+func OneOf[T any](gens ...Generator[T]) Generator[T] {
+	if len(gens) == 0 {
+		panic("OneOf requires at least one generator")
+	}
+
+	return func() T {
+		return gens[defaultRand.Intn(len(gens))]()
+	}
+}
+
+// FromSlice creates a Generator that returns values in order, starting over
+// from the first when they run out.
+//
+// It turns a counterexample found by a random run into a deterministic
+// regression test. Values are drawn in the order the property consumes
+// them, so a triple property reads three values per case:
+//
+//	// Associativity failed: (a∘b)∘c != a∘(b∘c)
+//	//   a=3, b=-7, c=12
+//	lawtest.Associative(t, merge, lawtest.FromSlice([]int{3, -7, 12}))
+//
+// The slice is copied, so later changes to values don't affect the
+// generator. Use FromSliceOnce to make running past the end an error.
+//
+// Panics if values is empty.
+func FromSlice[T any](values []T) Generator[T] {
+	return fromSlice(values, true)
+}
+
+// FromSliceOnce creates a Generator that returns values in order and
+// panics once they are exhausted.
+//
+// Use it when every value should be consumed exactly once, with
+// Config.TestCases set to match:
+//
+//	cfg := lawtest.DefaultConfig()
+//	cfg.TestCases = 2
+//	lawtest.CommutativeWithConfig(t, op, lawtest.FromSliceOnce([]int{1, 2, 5, 0}), cfg)
+//
+// Panics if values is empty.
+func FromSliceOnce[T any](values []T) Generator[T] {
+	return fromSlice(values, false)
+}
+
+func fromSlice[T any](values []T, cycle bool) Generator[T] {
+	if len(values) == 0 {
+		panic("FromSlice requires at least one value")
+	}
+
+	values = slices.Clone(values)
+	next := 0
+	return func() T {
+		if next == len(values) {
+			if !cycle {
+				panic(fmt.Sprintf("FromSliceOnce: all %d values have been used", len(values)))
+			}
+			next = 0
+		}
+
+		v := values[next]
+		next++
+		return v
+	}
+}
+
+// WithSeeds creates a Generator that returns each of seeds once, in order,
+// and then falls through to g.
+//
+// Seeding a generator with past counterexamples keeps them in every run, so
+// a fixed bug can't quietly come back, while the rest of the run still
+// explores random inputs. As with FromSlice, seeds are consumed in the order
+// the property draws its operands, three per case for a triple property:
+//
+//	// Historical failures of merge, as (a, b, c) triples
+//	seeds := []Cache{emptyCache, fullCache, emptyCache, staleCache, fullCache, staleCache}
+//	lawtest.Associative(t, merge, lawtest.WithSeeds(genCache, seeds))
+//
+// The seeds are used up by the first property run that draws from the
+// generator, so build a fresh one for each property. The slice is copied.
+func WithSeeds[T any](g Generator[T], seeds []T) Generator[T] {
+	seeds = slices.Clone(seeds)
+	next := 0
+
+	return func() T {
+		if next == len(seeds) {
+			return g()
+		}
+
+		v := seeds[next]
+		next++
+		return v
+	}
+}
+
+// SyncGen wraps g so that concurrent calls are serialized by a mutex.
+//
+// The built-in generators are already safe for concurrent use, but
+// generators holding their own state are not: those drawing from a
+// *rand.Rand (including the *Seeded constructors), FromSlice, or closures
+// over counters. TestParallelAssociativity wraps its generator with SyncGen
+// automatically; wrap generators yourself before calling them from your own
+// goroutines.
+//
+// Example:
+//
+//	gen := lawtest.SyncGen(lawtest.IntGenSeeded(-100, 100, cfg.Rand()))
+//	for i := 0; i < 8; i++ {
+//	    go func() { use(gen()) }()
+//	}
+func SyncGen[T any](g Generator[T]) Generator[T] {
+	var mu sync.Mutex
+	return func() T {
+		mu.Lock()
+		defer mu.Unlock()
+		return g()
+	}
+}
+
+// PtrGen creates a Generator that returns nil with probability
+// nilProbability and otherwise a pointer to a fresh value drawn from inner.
+//
+// Every non-nil pointer is newly allocated, so values never alias each
+// other. Use it to cover nil receivers and arguments, a common source of
+// panics in reference-type code:
+//
+//	gen := lawtest.PtrGen(CacheGen(), 0.1)
+//	lawtest.Associative(t, MergePtr, gen)
+//
+// Panics if nilProbability is not in [0, 1].
+func PtrGen[T any](inner Generator[T], nilProbability float64) Generator[*T] {
+	if !(nilProbability >= 0 && nilProbability <= 1) {
+		panic(fmt.Sprintf("nilProbability (%v) must be in [0, 1]", nilProbability))
+	}
+
+	return func() *T {
+		if defaultRand.Float64() < nilProbability {
+			return nil
+		}
+		v := inner()
+		return &v
+	}
+}
+
+// StructGen creates a Generator for the struct type T that fills each
+// exported field from the generator registered under its name.
+//
+// Every exported field of T must have an entry in fieldGens, and each entry
+// must be a function with no arguments returning a value assignable to the
+// field, such as a Generator of the field's type. Unexported fields are left
+// at their zero value.
+//
+// Example:
+//
+//	type Point struct{ X, Y int }
+//
+//	gen := lawtest.StructGen[Point](map[string]any{
+//	    "X": lawtest.IntGen(-100, 100),
+//	    "Y": lawtest.IntGen(-100, 100),
+//	})
+//
+// Panics if T is not a struct, if a field has no generator, or if a
+// generator names an unknown field or has an incompatible type.
+func StructGen[T any](fieldGens map[string]any) Generator[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("StructGen: %v is not a struct type", typ))
+	}
+
+	type fieldGen struct {
+		index int
+		gen   reflect.Value
+	}
+
+	var fields []fieldGen
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		g, ok := fieldGens[field.Name]
+		if !ok {
+			panic(fmt.Sprintf("StructGen: no generator for field %v.%s", typ, field.Name))
+		}
+
+		gen := reflect.ValueOf(g)
+		if gen.Kind() != reflect.Func || gen.IsNil() || gen.Type().NumIn() != 0 || gen.Type().NumOut() != 1 ||
+			!gen.Type().Out(0).AssignableTo(field.Type) {
+			panic(fmt.Sprintf("StructGen: generator for field %v.%s has type %T, want func() %v",
+				typ, field.Name, g, field.Type))
+		}
+
+		fields = append(fields, fieldGen{index: i, gen: gen})
+	}
+
+	for name := range fieldGens {
+		if field, ok := typ.FieldByName(name); !ok || !field.IsExported() || len(field.Index) != 1 {
+			panic(fmt.Sprintf("StructGen: %v has no exported field %s", typ, name))
+		}
+	}
+
+	return func() T {
+		var v T
+		rv := reflect.ValueOf(&v).Elem()
+		for _, f := range fields {
+			rv.Field(f.index).Set(f.gen.Call(nil)[0])
+		}
+		return v
+	}
+}
+
+// ===========================================================================
+// BOUNDARY VALUES
+// ===========================================================================
+
+// edgeOneIn is the inverse probability with which the *Edges generators
+// return a boundary value instead of a uniform draw.
+const edgeOneIn = 4
+
+// IntGenEdges is like IntGen but returns a boundary value about a quarter of
+// the time: min, max, 0, -1 or 1, whichever of them lie in [min, max].
+//
+// Off-by-one and overflow bugs cluster at these values, which a uniform draw
+// from a wide range almost never hits.
+//
+// Example:
+//
+//	gen := lawtest.IntGenEdges(math.MinInt32, math.MaxInt32)
+//	lawtest.Commutative(t, SaturatingAdd, gen)
+//
+// Panics if min > max.
+func IntGenEdges(min, max int) Generator[int] {
+	uniform := IntGen(min, max)
+
+	var edges []int
+	for _, v := range []int{min, max, 0, -1, 1} {
+		if v >= min && v <= max {
+			edges = append(edges, v)
+		}
+	}
+
+	return withEdges(uniform, edges)
+}
+
+// Float64GenEdges is like Float64Gen but returns a boundary value about a
+// quarter of the time.
+//
+// Boundary values are min and max, the neighbours of min and max, 0, ±1,
+// ±math.SmallestNonzeroFloat64 and ±math.MaxFloat64, whichever of them lie
+// in [min, max]. Infinities and NaN are never produced since they can't lie
+// in a range; combine with OneOf to add them explicitly.
+//
+// Panics if min > max.
+func Float64GenEdges(min, max float64) Generator[float64] {
+	uniform := Float64Gen(min, max)
+
+	candidates := []float64{
+		min, max,
+		math.Nextafter(min, math.Inf(1)), math.Nextafter(max, math.Inf(-1)),
+		0, 1, -1,
+		math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+		math.MaxFloat64, -math.MaxFloat64,
+	}
+
+	var edges []float64
+	for _, v := range candidates {
+		if v >= min && v <= max {
+			edges = append(edges, v)
+		}
+	}
+
+	return withEdges(uniform, edges)
+}
+
+// withEdges returns edges[i] with probability 1/edgeOneIn and a value from
+// uniform otherwise.
+func withEdges[T any](uniform Generator[T], edges []T) Generator[T] {
+	return func() T {
+		if len(edges) > 0 && defaultRand.Intn(edgeOneIn) == 0 {
+			return edges[defaultRand.Intn(len(edges))]
+		}
+		return uniform()
+	}
+}
+
+// ===========================================================================
+// NON-ZERO VALUES
+// ===========================================================================
+
+// NonZeroIntGen is like IntGen but never produces 0.
+//
+// Use it for divisors and for elements of multiplicative structures, where
+// zero has no inverse:
+//
+//	lawtest.Inverse(t, mulMod7, inverseMod7, 1, lawtest.NonZeroIntGen(1, 6))
+//
+// Values are uniformly distributed over the non-zero integers in
+// [min, max].
+//
+// Panics if min > max, or if the range contains only zero.
+func NonZeroIntGen(min, max int) Generator[int] {
+	if min > max {
+		panic(fmt.Sprintf("min (%d) must be <= max (%d)", min, max))
+	}
+	if min == 0 && max == 0 {
+		panic("NonZeroIntGen: the range [0, 0] contains only zero")
+	}
+	if min > 0 || max < 0 {
+		return IntGen(min, max)
+	}
+
+	// Draw from a range one shorter and shift the non-negative half up
+	// past zero.
+	gen := IntGen(min, max-1)
+	return func() int {
+		v := gen()
+		if v >= 0 {
+			v++
+		}
+		return v
+	}
+}
+
+// NonZeroFloat64Gen is like Float64Gen but never produces 0.
+//
+// Use it to test reciprocals and division without special-casing zero:
+//
+//	gen := lawtest.NonZeroFloat64Gen(-100, 100)
+//	x := gen() // never 0, so 1/x is finite
+//
+// Panics if min > max, or if the range contains only zero.
+func NonZeroFloat64Gen(min, max float64) Generator[float64] {
+	if min > max {
+		panic(fmt.Sprintf("min (%f) must be <= max (%f)", min, max))
+	}
+	if min == 0 && max == 0 {
+		panic("NonZeroFloat64Gen: the range [0, 0] contains only zero")
+	}
+
+	gen := Float64Gen(min, max)
+	return func() float64 {
+		for {
+			if v := gen(); v != 0 {
+				return v
+			}
+		}
+	}
+}
+
+// ===========================================================================
+// UNICODE
+// ===========================================================================
+
+// emoji covers the main pictographic emoji blocks, which the unicode package
+// has no table for.
+var emoji = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1}, // Miscellaneous Symbols, Dingbats
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1}, // Pictographs, Emoticons
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1}, // Transport and Map Symbols
+		{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1}, // Supplemental Symbols and Pictographs
+	},
+}
+
+// defaultUnicodeRanges are the rune ranges UnicodeStringGen draws from when
+// none are given.
+var defaultUnicodeRanges = []*unicode.RangeTable{unicode.Latin, unicode.Han, emoji, unicode.Mn}
+
+// RuneGen creates a Generator that produces runes from the given tables,
+// such as unicode.Greek or unicode.Mn.
+//
+// Each call first picks one of the tables uniformly and then a rune
+// uniformly within it, so a small table like unicode.Mn is drawn from as
+// often as a large one like unicode.Han.
+//
+// Example:
+//
+//	gen := lawtest.RuneGen(unicode.Cyrillic, unicode.Greek)
+//
+// Panics if no tables are given or a table is empty.
+func RuneGen(ranges ...*unicode.RangeTable) Generator[rune] {
+	if len(ranges) == 0 {
+		panic("RuneGen requires at least one range table")
+	}
+
+	tables := make([]runeTable, len(ranges))
+	for i, rt := range ranges {
+		tables[i] = newRuneTable(rt)
+		if tables[i].size == 0 {
+			panic(fmt.Sprintf("RuneGen: range table %d is empty", i))
+		}
+	}
+
+	return func() rune {
+		table := tables[defaultRand.Intn(len(tables))]
+		return table.at(defaultRand.Intn(table.size))
+	}
+}
+
+// UnicodeStringGen creates a Generator that produces strings of n runes
+// drawn from ranges. Without ranges it draws from Latin letters, CJK
+// ideographs, emoji and combining marks.
+//
+// Unlike StringGen, the output contains multibyte characters and combining
+// marks, so len(s) is generally larger than n and the strings may not be in
+// any normalization form. That is what makes it useful for testing text
+// operations against real-world input.
+//
+// Example:
+//
+//	lawtest.Idempotent(t, Normalize, lawtest.UnicodeStringGen(10))
+//
+// Panics if a table is empty.
+func UnicodeStringGen(n int, ranges ...*unicode.RangeTable) Generator[string] {
+	if len(ranges) == 0 {
+		ranges = defaultUnicodeRanges
+	}
+	runes := RuneGen(ranges...)
+
+	return func() string {
+		r := make([]rune, n)
+		for i := range r {
+			r[i] = runes()
+		}
+		return string(r)
+	}
+}
+
+// runeSpan is a run of code points lo, lo+stride, ... with count entries.
+type runeSpan struct {
+	lo, stride rune
+	count      int
+}
+
+// runeTable indexes the code points of a unicode.RangeTable.
+type runeTable struct {
+	spans []runeSpan
+	size  int
+}
+
+func newRuneTable(rt *unicode.RangeTable) runeTable {
+	var t runeTable
+	add := func(lo, hi, stride uint32) {
+		count := int((hi-lo)/stride) + 1
+		t.spans = append(t.spans, runeSpan{lo: rune(lo), stride: rune(stride), count: count})
+		t.size += count
+	}
+
+	for _, r := range rt.R16 {
+		add(uint32(r.Lo), uint32(r.Hi), uint32(r.Stride))
+	}
+	for _, r := range rt.R32 {
+		add(r.Lo, r.Hi, r.Stride)
+	}
+	return t
+}
+
+// at returns the i-th code point of the table.
+func (t runeTable) at(i int) rune {
+	for _, s := range t.spans {
+		if i < s.count {
+			return s.lo + rune(i)*s.stride
+		}
+		i -= s.count
+	}
+	panic("runeTable index out of range")
+}
+
+// ===========================================================================
+// ARBITRARY PRECISION
+// ===========================================================================
+
+// BigIntGen creates a Generator that produces random *big.Int values of up
+// to bits bits, with either sign.
+//
+// The bit length itself is random, so small values are as likely as values
+// near the limit. *big.Int is a pointer and can't be compared with ==, so
+// use it with the Custom property functions and BigIntEqual:
+//
+//	gen := lawtest.BigIntGen(512)
+//	mul := func(a, b *big.Int) *big.Int { return new(big.Int).Mul(a, b) }
+//	lawtest.AssociativeCustom(t, mul, gen, lawtest.BigIntEqual)
+//
+// Every call returns a fresh value, so operations may mutate their inputs
+// without affecting other test cases.
+//
+// Panics if bits < 1.
+func BigIntGen(bits int) Generator[*big.Int] {
+	if bits < 1 {
+		panic(fmt.Sprintf("bits (%d) must be >= 1", bits))
+	}
+
+	return func() *big.Int {
+		n := defaultRand.Intn(bits + 1)
+
+		buf := make([]byte, (n+7)/8)
+		for i := range buf {
+			buf[i] = byte(defaultRand.Intn(256))
+		}
+		if n%8 != 0 {
+			buf[0] &= byte(1)<<(n%8) - 1
+		}
+
+		v := new(big.Int).SetBytes(buf)
+		if defaultRand.Intn(2) == 0 {
+			v.Neg(v)
+		}
+		return v
+	}
+}
+
+// BigIntEqual reports whether a and b hold the same value.
+//
+// Two nil pointers are equal; a nil pointer is not equal to any value.
+func BigIntEqual(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
+
+// RatGen creates a Generator of exact fractions n/d with n in
+// [-maxNum, maxNum] and d in [1, maxDen].
+//
+// Rational arithmetic is exactly associative and distributive, unlike
+// float64, so the laws can be checked with plain equality through the
+// Custom property functions and RatEqual:
+//
+//	gen := lawtest.RatGen(100, 100)
+//	add := func(a, b *big.Rat) *big.Rat { return new(big.Rat).Add(a, b) }
+//	lawtest.AssociativeCustom(t, add, gen, lawtest.RatEqual)
+//
+// Every call returns a fresh value, normalized to lowest terms.
+//
+// Panics if maxNum < 0 or maxDen < 1.
+func RatGen(maxNum, maxDen int) Generator[*big.Rat] {
+	if maxNum < 0 {
+		panic(fmt.Sprintf("maxNum (%d) must be >= 0", maxNum))
+	}
+	if maxDen < 1 {
+		panic(fmt.Sprintf("maxDen (%d) must be >= 1", maxDen))
+	}
+	num, den := IntGen(-maxNum, maxNum), IntGen(1, maxDen)
+
+	return func() *big.Rat {
+		return big.NewRat(int64(num()), int64(den()))
+	}
+}
+
+// RatEqual reports whether a and b hold the same value.
+//
+// Two nil pointers are equal; a nil pointer is not equal to any value.
+func RatEqual(a, b *big.Rat) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
+
+// Node is a binary tree node, as produced by TreeGen. A nil *Node is the
+// empty tree.
+type Node[T any] struct {
+	Value       T
+	Left, Right *Node[T]
+}
+
+// String formats the tree as (left value right), with _ for an empty
+// subtree, so failure messages show its shape rather than a pointer.
+func (n *Node[T]) String() string {
+	if n == nil {
+		return "_"
+	}
+	return fmt.Sprintf("(%v %v %v)", n.Left, n.Value, n.Right)
+}
+
+// TreeGen creates a Generator that produces random binary trees of at most
+// maxDepth levels, with values drawn from leafGen.
+//
+// Every subtree is empty with probability 1/3, so the generator covers the
+// empty tree, single nodes, and lopsided as well as bushy trees. The depth
+// bound keeps the size of a tree below 2^maxDepth nodes.
+//
+// Example:
+//
+//	gen := lawtest.TreeGen(lawtest.IntGen(0, 99), 6)
+//	lawtest.EquivalentCustom(t, FlattenRecursive, FlattenIterative, gen, slices.Equal[[]int])
+//	lawtest.EquivalentCustom(t, Mirror, MirrorIterative, gen, lawtest.TreeEqual[int])
+//
+// Panics if maxDepth < 0.
+func TreeGen[T any](leafGen Generator[T], maxDepth int) Generator[*Node[T]] {
+	if maxDepth < 0 {
+		panic(fmt.Sprintf("maxDepth (%d) must be >= 0", maxDepth))
+	}
+
+	var grow func(depth int) *Node[T]
+	grow = func(depth int) *Node[T] {
+		if depth == 0 || defaultRand.Intn(3) == 0 {
+			return nil
+		}
+		return &Node[T]{
+			Value: leafGen(),
+			Left:  grow(depth - 1),
+			Right: grow(depth - 1),
+		}
+	}
+
+	return func() *Node[T] {
+		return grow(maxDepth)
+	}
+}
+
+// TreeEqual reports whether a and b have the same shape and equal values
+// at every node. Two empty trees are equal.
+func TreeEqual[T comparable](a, b *Node[T]) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Value == b.Value && TreeEqual(a.Left, b.Left) && TreeEqual(a.Right, b.Right)
+}
+
+// jsonMaxDepth bounds the nesting of arrays and objects produced by
+// JSONGen.
+const jsonMaxDepth = 3
+
+// jsonRunes are the characters JSONGen builds strings from: letters, the
+// characters JSON must escape, those encoding/json escapes for HTML safety,
+// and multi-byte runes.
+var jsonRunes = []rune("abcXYZ019 \"\\/\n\t<>&\u2028éß世😀")
+
+// JSONGen creates a Generator of random values in the form encoding/json
+// decodes into an any: nil, bool, float64, string, []any and
+// map[string]any, nested up to three levels deep.
+//
+// Every value survives json.Marshal followed by json.Unmarshal unchanged,
+// as compared by reflect.DeepEqual: numbers are finite and include
+// decimal fractions and integers beyond float64's exact range; strings are
+// valid UTF-8 with characters that need escaping; and arrays and objects are
+// never nil, since a nil slice or map marshals as null. A type whose JSON
+// encoding is not faithful fails the same round trip:
+//
+//	encode := func(v any) []byte { b, _ := json.Marshal(v); return b }
+//	decode := func(b []byte) any { var v any; json.Unmarshal(b, &v); return v }
+//	lawtest.RoundTripCustom(t, encode, decode, lawtest.JSONGen(), func(a, b any) bool {
+//	    return reflect.DeepEqual(a, b)
+//	})
+func JSONGen() Generator[any] {
+	var value func(depth int) any
+	value = func(depth int) any {
+		kinds := 6
+		if depth == 0 {
+			kinds = 4 // Only scalars at the deepest level
+		}
+
+		switch defaultRand.Intn(kinds) {
+		case 0:
+			return nil
+		case 1:
+			return defaultRand.Intn(2) == 1
+		case 2:
+			return jsonNumber()
+		case 3:
+			return jsonString()
+		case 4:
+			arr := make([]any, defaultRand.Intn(4))
+			for i := range arr {
+				arr[i] = value(depth - 1)
+			}
+			return arr
+		default:
+			n := defaultRand.Intn(4)
+			obj := make(map[string]any, n)
+			for i := 0; i < n; i++ {
+				obj[jsonString()] = value(depth - 1)
+			}
+			return obj
+		}
+	}
+
+	return func() any {
+		return value(jsonMaxDepth)
+	}
+}
+
+// jsonNumber returns a finite float64, mixing small integers, fractions and
+// large magnitudes.
+func jsonNumber() float64 {
+	switch defaultRand.Intn(4) {
+	case 0:
+		return float64(defaultRand.Intn(2001) - 1000)
+	case 1:
+		return (defaultRand.Float64() - 0.5) * 1e3
+	case 2:
+		return float64(defaultRand.Int63()) * float64(1-2*defaultRand.Intn(2))
+	default:
+		return float64(defaultRand.Intn(100)) / 10
+	}
+}
+
+// jsonString returns a short string drawn from jsonRunes.
+func jsonString() string {
+	r := make([]rune, defaultRand.Intn(6))
+	for i := range r {
+		r[i] = jsonRunes[defaultRand.Intn(len(jsonRunes))]
+	}
+	return string(r)
+}
+
+// ===========================================================================
+// SEEDED GENERATORS
+// ===========================================================================
+
+// lockedRand is a random source that is safe for concurrent use and can be
+// reseeded between property runs.
+type lockedRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func (l *lockedRand) Intn(n int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Intn(n)
+}
+
+func (l *lockedRand) Float64() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Float64()
+}
+
+func (l *lockedRand) Int63() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Int63()
+}
+
+func (l *lockedRand) Seed(seed int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.r.Seed(seed)
+}
+
+// defaultRand drives the built-in generators (IntGen, StringGen, ...).
+// Every property run reseeds it, so the inputs of a test running on its own
+// are determined by the seed it logs.
+var defaultRand = &lockedRand{r: NewRand(time.Now().UnixNano())}
+
+// NewRand returns a random source seeded with seed.
+//
+// The built-in generators share a source that each property run reseeds
+// with Config.Seed, so they replay automatically as long as no other test
+// draws from it at the same time. Tests that run in parallel, or properties
+// that draw from goroutines of their own, should build their generators
+// with the *Seeded constructors from a source of their own, such as
+// Config.Rand, which keeps their sequence independent of other tests.
+//
+// A *rand.Rand is not safe for concurrent use. Give each goroutine its own
+// source rather than sharing one between concurrent generators.
+//
+// Example:
+//
+//	r := lawtest.NewRand(1234)
+//	gen := lawtest.IntGenSeeded(-100, 100, r)
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// IntGenSeeded is like IntGen but draws from r.
+//
+// Panics if min > max.
+func IntGenSeeded(min, max int, r *rand.Rand) Generator[int] {
+	return intGen(min, max, r.Intn)
+}
+
+// StringGenSeeded is like StringGen but draws from r.
+func StringGenSeeded(n int, r *rand.Rand) Generator[string] {
+	return stringGen(n, r.Intn)
+}
+
+// Float64GenSeeded is like Float64Gen but draws from r.
+//
+// Panics if min > max.
+func Float64GenSeeded(min, max float64, r *rand.Rand) Generator[float64] {
+	return float64Gen(min, max, r.Float64)
+}
+
+// BoolGenSeeded is like BoolGen but draws from r.
+func BoolGenSeeded(r *rand.Rand) Generator[bool] {
+	return boolGen(r.Intn)
+}
@@ -0,0 +1,869 @@
+package lawtest_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Test that labeled generators attribute their values and cases per run
+func TestGeneratorLabel(t *testing.T) {
+	addOp := func(a, b int) int { return a + b }
+
+	// Runs in parallel with Configs of their own keep their tallies apart
+	results := make([]lawtest.Result[int], 2)
+	var wg sync.WaitGroup
+	for i, prefix := range []string{"first", "second"} {
+		wg.Add(1)
+		go func(i int, prefix string) {
+			defer wg.Done()
+			cfg := lawtest.DefaultConfig()
+			gen := lawtest.OneOf(
+				lawtest.IntGen(-10, 10).Label(cfg, prefix+"/small"),
+				lawtest.IntGen(-1000000, 1000000).Label(cfg, prefix+"/large"),
+			)
+			results[i] = lawtest.CheckAssociative(addOp, gen, cfg)
+		}(i, prefix)
+	}
+	wg.Wait()
+
+	for i, prefix := range []string{"first", "second"} {
+		stats := results[i].Stats
+		small, large := stats.Sources[prefix+"/small"], stats.Sources[prefix+"/large"]
+		if len(stats.Sources) != 2 || small.Values == 0 || large.Values == 0 {
+			t.Fatalf("Expected both of the run's own sources to contribute, got %v", stats.Sources)
+		}
+		if small.Values+large.Values != stats.Inputs || small.Failed+large.Failed != 0 {
+			t.Errorf("Expected every input attributed to a passing case, got %v", stats)
+		}
+	}
+
+	// Failing cases are attributed to the sources they drew from
+	subOp := func(a, b int) int { return a - b }
+	cfg := lawtest.DefaultConfig()
+	cfg.ReportAll = true
+	gen := lawtest.OneOf(lawtest.IntGen(0, 0).Label(cfg, "zero"), lawtest.IntGen(1, 1).Label(cfg, "one"))
+	for name, src := range lawtest.CheckCommutative(subOp, gen, cfg).Stats.Sources {
+		if src.Passed == 0 || src.Failed == 0 {
+			t.Errorf("Expected %q to be drawn by passing and failing cases, got %+v", name, src)
+		}
+	}
+
+	s := lawtest.Stats{Sources: map[string]lawtest.SourceStats{"b": {Values: 5, Passed: 2, Failed: 1}, "a": {Values: 2, Passed: 1}}}
+	if !strings.HasSuffix(s.String(), "sources: a 2 values in 1 cases (1 passed), b 5 values in 3 cases (2 passed, 1 failed)") {
+		t.Errorf("Expected sources sorted by name, got %q", s.String())
+	}
+
+	// Each run starts from empty tallies
+	if res := lawtest.CheckCommutative(addOp, lawtest.IntGen(0, 9), cfg); res.Stats.Sources != nil {
+		t.Errorf("Expected no sources without labeled generators, got %v", res.Stats.Sources)
+	}
+}
+
+// Test that seeded generators replay the same sequence
+func TestSeededGenerators(t *testing.T) {
+	sample := func(seed int64) []any {
+		r := lawtest.NewRand(seed)
+		ints := lawtest.IntGenSeeded(-100, 100, r)
+		strs := lawtest.StringGenSeeded(6, r)
+		floats := lawtest.Float64GenSeeded(0, 1, r)
+		bools := lawtest.BoolGenSeeded(r)
+
+		var out []any
+		for i := 0; i < 20; i++ {
+			out = append(out, ints(), strs(), floats(), bools())
+		}
+		return out
+	}
+
+	if a, b := sample(42), sample(42); !reflect.DeepEqual(a, b) {
+		t.Errorf("Same seed produced different sequences:\n  %v\n  %v", a, b)
+	}
+
+	if a, b := sample(42), sample(43); reflect.DeepEqual(a, b) {
+		t.Error("Different seeds produced identical sequences")
+	}
+
+	t.Run("ConfigRand", func(t *testing.T) {
+		cfg := lawtest.DefaultConfig()
+		cfg.Seed = 7
+
+		a := lawtest.IntGenSeeded(0, 1000, cfg.Rand())
+		b := lawtest.IntGenSeeded(0, 1000, cfg.Rand())
+		for i := 0; i < 10; i++ {
+			if x, y := a(), b(); x != y {
+				t.Fatalf("Expected cfg.Rand() to replay, got %d and %d", x, y)
+			}
+		}
+
+		addOp := func(a, b int) int { return a + b }
+		lawtest.AssociativeWithConfig(t, addOp, lawtest.IntGenSeeded(-100, 100, cfg.Rand()), cfg)
+	})
+
+	t.Run("ConfigRandPinsSeed", func(t *testing.T) {
+		cfg := lawtest.DefaultConfig()
+		first := cfg.Rand().Int63()
+
+		addOp := func(a, b int) int { return a + b }
+		res := lawtest.CheckCommutative(addOp, lawtest.IntGen(-100, 100), cfg)
+
+		replay := &lawtest.Config{Seed: res.Seed}
+		if res.Seed == 0 || replay.Rand().Int63() != first {
+			t.Errorf("Expected the reported seed %d to replay cfg.Rand()", res.Seed)
+		}
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for min > max")
+			}
+		}()
+		_ = lawtest.IntGenSeeded(10, 5, lawtest.NewRand(1))
+	})
+}
+
+// Test that Config.Seed replays the built-in generators
+func TestConfigSeedReplaysBuiltinGenerators(t *testing.T) {
+	if f := flag.Lookup("lawtest.seed"); f != nil && f.Value.String() != "0" {
+		t.Skip("-lawtest.seed overrides Config.Seed")
+	}
+
+	record := func(seed int64) []int {
+		var seen []int
+		gen := lawtest.IntGen(-1000, 1000)
+		recording := func() int {
+			v := gen()
+			seen = append(seen, v)
+			return v
+		}
+
+		cfg := lawtest.DefaultConfig()
+		cfg.Seed = seed
+		cfg.LogSeed = true
+		addOp := func(a, b int) int { return a + b }
+		lawtest.CommutativeWithConfig(t, addOp, recording, cfg)
+		return seen
+	}
+
+	first, second := record(2024), record(2024)
+	if !reflect.DeepEqual(first, second) {
+		t.Error("Expected the same seed to replay the same inputs")
+	}
+
+	if reflect.DeepEqual(first, record(2025)) {
+		t.Error("Expected a different seed to produce different inputs")
+	}
+}
+
+// Test that SliceGen respects its length bounds
+func TestSliceGen(t *testing.T) {
+	gen := lawtest.SliceGen(lawtest.IntGen(-5, 5), 2, 6)
+
+	lengths := map[int]bool{}
+	for i := 0; i < 500; i++ {
+		s := gen()
+		if len(s) < 2 || len(s) > 6 {
+			t.Fatalf("Length %d out of range [2, 6]", len(s))
+		}
+		for _, v := range s {
+			if v < -5 || v > 5 {
+				t.Fatalf("Element %d out of range [-5, 5]", v)
+			}
+		}
+		lengths[len(s)] = true
+	}
+	if len(lengths) != 5 {
+		t.Errorf("Expected every length in [2, 6], got %v", lengths)
+	}
+
+	concat := func(a, b []int) []int { return append(append([]int(nil), a...), b...) }
+	lawtest.AssociativeCustom(t, concat, lawtest.SliceGen(lawtest.IntGen(0, 9), 0, 5), slices.Equal[[]int])
+
+	for _, bounds := range [][2]int{{-1, 3}, {4, 3}} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for bounds %v", bounds)
+				}
+			}()
+			lawtest.SliceGen(lawtest.IntGen(0, 1), bounds[0], bounds[1])
+		}()
+	}
+}
+
+// Test that SortedSliceGen produces sorted slices for merge properties
+func TestSortedSliceGen(t *testing.T) {
+	merge := func(a, b []int) []int {
+		out := make([]int, 0, len(a)+len(b))
+		for len(a) > 0 && len(b) > 0 {
+			if a[0] <= b[0] {
+				out, a = append(out, a[0]), a[1:]
+			} else {
+				out, b = append(out, b[0]), b[1:]
+			}
+		}
+		return append(append(out, a...), b...)
+	}
+
+	gen := lawtest.SortedSliceGen(lawtest.IntGen(-50, 50), 0, 10)
+	lawtest.AssociativeCustom(t, merge, gen, slices.Equal[[]int])
+
+	for i := 0; i < 100; i++ {
+		a, b := gen(), gen()
+		if !lawtest.IsSorted(a) || len(a) > 10 {
+			t.Fatalf("Expected a sorted slice of at most 10 elements, got %v", a)
+		}
+		if merged := merge(a, b); !lawtest.IsSorted(merged) {
+			t.Fatalf("Expected merged output to be sorted, got %v", merged)
+		}
+	}
+
+	if lawtest.IsSorted([]string{"b", "a"}) || !lawtest.IsSorted([]string{}) {
+		t.Error("Expected IsSorted to check ascending order")
+	}
+}
+
+// Test that MapGen respects its size bounds and tolerates collisions
+func TestMapGen(t *testing.T) {
+	gen := lawtest.MapGen(lawtest.IntGen(0, 1000), lawtest.StringGen(2), 0, 8)
+	for i := 0; i < 500; i++ {
+		if m := gen(); len(m) > 8 {
+			t.Fatalf("Map size %d exceeds maxLen 8", len(m))
+		}
+	}
+
+	// Only two distinct keys exist, so most maps collapse
+	small := lawtest.MapGen(lawtest.BoolGen(), lawtest.IntGen(0, 9), 5, 5)
+	for i := 0; i < 100; i++ {
+		if m := small(); len(m) == 0 || len(m) > 2 {
+			t.Fatalf("Expected 1 or 2 keys, got %v", m)
+		}
+	}
+
+	union := func(a, b map[int]bool) map[int]bool {
+		out := maps.Clone(a)
+		maps.Copy(out, b)
+		return out
+	}
+	sets := lawtest.MapGen(lawtest.IntGen(0, 20), func() bool { return true }, 0, 6)
+	lawtest.AssociativeCustom(t, union, sets, maps.Equal[map[int]bool])
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for minLen > maxLen")
+			}
+		}()
+		lawtest.MapGen(lawtest.IntGen(0, 1), lawtest.IntGen(0, 1), 3, 2)
+	}()
+}
+
+// Test that Map and Filter derive generators
+func TestMapAndFilter(t *testing.T) {
+	positiveEven := lawtest.Map(
+		lawtest.Filter(lawtest.IntGen(-100, 100), func(n int) bool { return n > 0 }, 100),
+		func(n int) int { return 2 * n },
+	)
+	for i := 0; i < 200; i++ {
+		if v := positiveEven(); v <= 0 || v%2 != 0 {
+			t.Fatalf("Expected a positive even int, got %d", v)
+		}
+	}
+
+	lengths := lawtest.Map(lawtest.StringGen(4), func(s string) int { return len(s) })
+	if n := lengths(); n != 4 {
+		t.Errorf("Expected mapped length 4, got %d", n)
+	}
+
+	t.Run("Exhausted", func(t *testing.T) {
+		never := lawtest.Filter(lawtest.IntGen(0, 10), func(n int) bool { return n > 10 }, 5)
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic when no value satisfies the predicate")
+			}
+		}()
+		never()
+	})
+}
+
+// Test that OneOf draws from every generator
+func TestOneOf(t *testing.T) {
+	gen := lawtest.OneOf(
+		lawtest.IntGen(0, 9),
+		lawtest.IntGen(100, 109),
+		lawtest.IntGen(1000, 1009),
+	)
+
+	counts := lawtest.Histogram(gen, 300, func(n int) string {
+		switch {
+		case n < 100:
+			return "low"
+		case n < 1000:
+			return "high"
+		}
+		return "huge"
+	})
+	for _, name := range []string{"low", "high", "huge"} {
+		if counts[name] == 0 {
+			t.Errorf("Expected %q to be picked, got %v", name, counts)
+		}
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for no generators")
+			}
+		}()
+		lawtest.OneOf[int]()
+	}()
+}
+
+// Test product generators and their readable output
+func TestPairGen(t *testing.T) {
+	gen := lawtest.PairGen(lawtest.IntGen(0, 3), lawtest.StringGen(1))
+	seen := map[lawtest.Pair[int, string]]bool{}
+	for i := 0; i < 100; i++ {
+		p := gen()
+		if p.A < 0 || p.A > 3 || len(p.B) != 1 {
+			t.Fatalf("Pair out of range: %v", p)
+		}
+		seen[p] = true
+	}
+	if len(seen) < 10 {
+		t.Errorf("Expected varied pairs, got %d distinct", len(seen))
+	}
+
+	// Componentwise addition on pairs is commutative and associative
+	add := func(x, y lawtest.Pair[int, float64]) lawtest.Pair[int, float64] {
+		return lawtest.Pair[int, float64]{A: x.A + y.A, B: x.B + y.B}
+	}
+	ints := lawtest.PairGen(lawtest.IntGen(-100, 100), lawtest.Map(lawtest.IntGen(-100, 100), func(n int) float64 { return float64(n) }))
+	lawtest.Commutative(t, add, ints)
+	lawtest.Associative(t, add, ints)
+
+	triple := lawtest.TripleGen(lawtest.IntGen(1, 1), lawtest.BoolGen(), lawtest.StringGen(2))()
+	if triple.A != 1 || len(triple.C) != 2 {
+		t.Errorf("Unexpected triple %v", triple)
+	}
+
+	if s := fmt.Sprint(lawtest.Pair[int, string]{3, "x"}); s != "(3, x)" {
+		t.Errorf("Expected pairs to print as (a, b), got %q", s)
+	}
+	if s := fmt.Sprint(lawtest.Triple[int, bool, string]{1, true, "y"}); s != "(1, true, y)" {
+		t.Errorf("Expected triples to print as (a, b, c), got %q", s)
+	}
+}
+
+// Test that FromSlice replays fixed values and pins a counterexample
+func TestFromSlice(t *testing.T) {
+	values := []int{3, -7, 12}
+	gen := lawtest.FromSlice(values)
+	values[0] = 99
+
+	var got []int
+	for i := 0; i < 7; i++ {
+		got = append(got, gen())
+	}
+	if want := []int{3, -7, 12, 3, -7, 12, 3}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	log := &failureLog{}
+	sub := func(a, b int) int { return a - b }
+	lawtest.Associative(log, sub, lawtest.FromSlice([]int{3, -7, 12}))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "a=3, b=-7, c=12") {
+		t.Errorf("Expected the pinned counterexample, got %q", log.errors)
+	}
+
+	once := lawtest.FromSliceOnce([]string{"a", "b"})
+	if once() != "a" || once() != "b" {
+		t.Error("Expected values in order")
+	}
+
+	for name, f := range map[string]func(){
+		"exhausted": func() { once() },
+		"empty":     func() { lawtest.FromSlice([]int{}) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic when %s", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+// Test that WithSeeds replays its seeds once before drawing from the generator
+func TestWithSeeds(t *testing.T) {
+	seeds := []int{3, -7, 12}
+	gen := lawtest.WithSeeds(lawtest.IntGen(100, 200), seeds)
+	seeds[0] = 99
+
+	for i, want := range []int{3, -7, 12} {
+		if got := gen(); got != want {
+			t.Errorf("Draw %d: expected seed %d, got %d", i, want, got)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		if v := gen(); v < 100 || v > 200 {
+			t.Fatalf("Expected random values after the seeds, got %d", v)
+		}
+	}
+
+	// A known counterexample among random triples is always caught
+	log := &failureLog{}
+	sub := func(a, b int) int { return a - b }
+	lawtest.Associative(log, sub, lawtest.WithSeeds(lawtest.IntGen(0, 0), []int{3, -7, 12}))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "a=3, b=-7, c=12") {
+		t.Errorf("Expected the seeded counterexample, got %q", log.errors)
+	}
+}
+
+// Test that SyncGen serializes a stateful generator across goroutines
+func TestSyncGen(t *testing.T) {
+	next := 0
+	gen := lawtest.SyncGen(func() int {
+		next++
+		return next
+	})
+
+	const goroutines, calls = 8, 1000
+	seen := make([][]int, goroutines)
+	var wg sync.WaitGroup
+	for g := range seen {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < calls; i++ {
+				seen[g] = append(seen[g], gen())
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	var all []int
+	for _, s := range seen {
+		all = append(all, s...)
+	}
+	slices.Sort(all)
+	if len(slices.Compact(all)) != goroutines*calls || next != goroutines*calls {
+		t.Errorf("Expected %d distinct values, got %d after %d calls", goroutines*calls, len(all), next)
+	}
+
+	// TestParallelAssociativity accepts a generator that isn't safe on its own
+	add := func(a, b int) int { return a + b }
+	lawtest.TestParallelAssociativity(t, add, lawtest.IntGenSeeded(-100, 100, lawtest.NewRand(1)), 8)
+}
+
+// Test that PtrGen mixes nil with fresh pointers
+func TestPtrGen(t *testing.T) {
+	gen := lawtest.PtrGen(lawtest.IntGen(0, 9), 0.3)
+
+	nils := 0
+	seen := map[*int]bool{}
+	for i := 0; i < 1000; i++ {
+		p := gen()
+		if p == nil {
+			nils++
+			continue
+		}
+		if seen[p] {
+			t.Fatal("Expected every pointer to be freshly allocated")
+		}
+		seen[p] = true
+		if *p < 0 || *p > 9 {
+			t.Fatalf("Value %d out of range [0, 9]", *p)
+		}
+	}
+	if nils < 200 || nils > 400 {
+		t.Errorf("Expected about 300 nils, got %d", nils)
+	}
+
+	if p := lawtest.PtrGen(lawtest.IntGen(0, 9), 0)(); p == nil {
+		t.Error("Expected no nils with probability 0")
+	}
+	if p := lawtest.PtrGen(lawtest.IntGen(0, 9), 1)(); p != nil {
+		t.Error("Expected only nils with probability 1")
+	}
+
+	for _, bad := range []float64{-0.1, 1.5, math.NaN()} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for nilProbability %v", bad)
+				}
+			}()
+			lawtest.PtrGen(lawtest.IntGen(0, 9), bad)
+		}()
+	}
+}
+
+// Test that the edge generators hit boundaries and stay in range
+func TestGenEdges(t *testing.T) {
+	ints := lawtest.IntGenEdges(math.MinInt32, math.MaxInt32)
+	seen := map[int]bool{}
+	for i := 0; i < 1000; i++ {
+		seen[ints()] = true
+	}
+	for _, edge := range []int{math.MinInt32, math.MaxInt32, 0, -1, 1} {
+		if !seen[edge] {
+			t.Errorf("Expected boundary value %d to be generated", edge)
+		}
+	}
+
+	narrow := lawtest.IntGenEdges(5, 10)
+	for i := 0; i < 500; i++ {
+		if v := narrow(); v < 5 || v > 10 {
+			t.Fatalf("Value %d out of range [5, 10]", v)
+		}
+	}
+
+	floats := lawtest.Float64GenEdges(-1e300, 1e300)
+	hitZero, hitTiny := false, false
+	for i := 0; i < 2000; i++ {
+		v := floats()
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("Unexpected non-finite value %v", v)
+		}
+		hitZero = hitZero || v == 0
+		hitTiny = hitTiny || v == math.SmallestNonzeroFloat64
+	}
+	if !hitZero || !hitTiny {
+		t.Errorf("Expected 0 and the smallest positive float, got zero=%v tiny=%v", hitZero, hitTiny)
+	}
+
+	unit := lawtest.Float64GenEdges(0.25, 0.75)
+	for i := 0; i < 500; i++ {
+		if v := unit(); v < 0.25 || v > 0.75 {
+			t.Fatalf("Value %v out of range [0.25, 0.75]", v)
+		}
+	}
+}
+
+// Test that the non-zero generators skip zero and stay in range
+func TestNonZeroGen(t *testing.T) {
+	ints := lawtest.NonZeroIntGen(-2, 2)
+	seen := map[int]bool{}
+	for i := 0; i < 1000; i++ {
+		v := ints()
+		if v == 0 || v < -2 || v > 2 {
+			t.Fatalf("Unexpected value %d", v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != 4 {
+		t.Errorf("Expected all four non-zero values, got %v", seen)
+	}
+
+	for _, r := range [][2]int{{0, 3}, {-3, 0}, {5, 9}} {
+		gen := lawtest.NonZeroIntGen(r[0], r[1])
+		for i := 0; i < 200; i++ {
+			if v := gen(); v == 0 || v < r[0] || v > r[1] {
+				t.Fatalf("Value %d out of range %v", v, r)
+			}
+		}
+	}
+
+	floats := lawtest.NonZeroFloat64Gen(0, 1)
+	for i := 0; i < 1000; i++ {
+		if v := floats(); v == 0 || v > 1 {
+			t.Fatalf("Unexpected value %v", v)
+		}
+	}
+
+	// Reciprocal inverts multiplication exactly on ±2^n, signed by n ≠ 0
+	mul := func(a, b float64) float64 { return a * b }
+	powers := lawtest.Map(lawtest.NonZeroIntGen(-8, 8), func(n int) float64 {
+		return math.Copysign(math.Ldexp(1, n), float64(n))
+	})
+	lawtest.Inverse(t, mul, func(x float64) float64 { return 1 / x }, 1, powers)
+
+	for name, f := range map[string]func(){
+		"int zero":   func() { lawtest.NonZeroIntGen(0, 0) },
+		"int order":  func() { lawtest.NonZeroIntGen(3, 1) },
+		"float zero": func() { lawtest.NonZeroFloat64Gen(0, 0) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for %s", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+// Test that StructGen fills exported fields from their generators
+func TestStructGen(t *testing.T) {
+	type Point struct {
+		X, Y  int
+		Label string
+		note  string
+	}
+
+	gen := lawtest.StructGen[Point](map[string]any{
+		"X":     lawtest.IntGen(-10, 10),
+		"Y":     lawtest.IntGen(100, 110),
+		"Label": func() string { return "p" },
+	})
+
+	for i := 0; i < 100; i++ {
+		p := gen()
+		if p.X < -10 || p.X > 10 || p.Y < 100 || p.Y > 110 || p.Label != "p" || p.note != "" {
+			t.Fatalf("Unexpected point %+v", p)
+		}
+	}
+
+	add := func(a, b Point) Point { return Point{X: a.X + b.X, Y: a.Y + b.Y, Label: "p"} }
+	lawtest.Commutative(t, add, gen)
+
+	invalid := map[string]map[string]any{
+		"MissingField": {"X": lawtest.IntGen(0, 1), "Y": lawtest.IntGen(0, 1)},
+		"UnknownField": {"X": lawtest.IntGen(0, 1), "Y": lawtest.IntGen(0, 1), "Label": lawtest.StringGen(1), "Z": lawtest.IntGen(0, 1)},
+		"WrongType":    {"X": lawtest.StringGen(1), "Y": lawtest.IntGen(0, 1), "Label": lawtest.StringGen(1)},
+		"NotAFunc":     {"X": 3, "Y": lawtest.IntGen(0, 1), "Label": lawtest.StringGen(1)},
+	}
+	for name, gens := range invalid {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic")
+				}
+			}()
+			lawtest.StructGen[Point](gens)
+		})
+	}
+}
+
+// Test that BigIntGen stays within its bit length and covers both signs
+func TestBigIntGen(t *testing.T) {
+	gen := lawtest.BigIntGen(200)
+
+	negative, wide := false, false
+	for i := 0; i < 500; i++ {
+		v := gen()
+		if v.BitLen() > 200 {
+			t.Fatalf("Value %v exceeds 200 bits", v)
+		}
+		negative = negative || v.Sign() < 0
+		wide = wide || v.BitLen() > 64
+	}
+	if !negative || !wide {
+		t.Errorf("Expected negative and wider-than-int64 values, got negative=%v wide=%v", negative, wide)
+	}
+
+	add := func(a, b *big.Int) *big.Int { return new(big.Int).Add(a, b) }
+	mul := func(a, b *big.Int) *big.Int { return new(big.Int).Mul(a, b) }
+	lawtest.AssociativeCustom(t, add, gen, lawtest.BigIntEqual)
+	lawtest.AssociativeCustom(t, mul, gen, lawtest.BigIntEqual)
+
+	if !lawtest.BigIntEqual(big.NewInt(7), big.NewInt(7)) || lawtest.BigIntEqual(big.NewInt(7), nil) {
+		t.Error("BigIntEqual compared values incorrectly")
+	}
+}
+
+// Test RatGen stays in bounds and satisfies the field laws exactly
+func TestRatGen(t *testing.T) {
+	gen := lawtest.RatGen(10, 6)
+
+	fractional, negative := false, false
+	for i := 0; i < 500; i++ {
+		v := gen()
+		if v.Denom().Int64() > 6 || new(big.Int).Abs(v.Num()).Int64() > 10 {
+			t.Fatalf("Value %v is out of bounds", v)
+		}
+		fractional = fractional || !v.IsInt()
+		negative = negative || v.Sign() < 0
+	}
+	if !fractional || !negative {
+		t.Errorf("Expected negative and fractional values, got negative=%v fractional=%v", negative, fractional)
+	}
+
+	add := func(a, b *big.Rat) *big.Rat { return new(big.Rat).Add(a, b) }
+	mul := func(a, b *big.Rat) *big.Rat { return new(big.Rat).Mul(a, b) }
+	lawtest.AssociativeCustom(t, add, gen, lawtest.RatEqual)
+	lawtest.AssociativeCustom(t, mul, gen, lawtest.RatEqual)
+	lawtest.EquivalentCustom(t,
+		func(v *big.Rat) *big.Rat { return mul(v, add(v, big.NewRat(1, 2))) },
+		func(v *big.Rat) *big.Rat { return add(mul(v, v), mul(v, big.NewRat(1, 2))) },
+		gen, lawtest.RatEqual)
+
+	if !lawtest.RatEqual(big.NewRat(2, 4), big.NewRat(1, 2)) || lawtest.RatEqual(big.NewRat(1, 2), nil) {
+		t.Error("RatEqual compared values incorrectly")
+	}
+	if zero := lawtest.RatGen(0, 1)(); zero.Sign() != 0 {
+		t.Errorf("Expected RatGen(0, 1) to produce zero, got %v", zero)
+	}
+}
+
+// jsonDepth returns the nesting depth of a decoded JSON value
+func jsonDepth(v any) int {
+	depth := 0
+	switch v := v.(type) {
+	case []any:
+		for _, e := range v {
+			depth = max(depth, jsonDepth(e))
+		}
+		return depth + 1
+	case map[string]any:
+		for _, e := range v {
+			depth = max(depth, jsonDepth(e))
+		}
+		return depth + 1
+	}
+	return 0
+}
+
+// Test JSONGen values are varied, bounded and survive a JSON round trip
+func TestJSONGen(t *testing.T) {
+	gen := lawtest.JSONGen()
+
+	kinds := map[string]bool{}
+	for i := 0; i < 500; i++ {
+		v := gen()
+		if d := jsonDepth(v); d > 3 {
+			t.Fatalf("Value %v is nested %d levels deep", v, d)
+		}
+		kinds[fmt.Sprintf("%T", v)] = true
+	}
+	for _, kind := range []string{"<nil>", "bool", "float64", "string", "[]interface {}", "map[string]interface {}"} {
+		if !kinds[kind] {
+			t.Errorf("Expected JSONGen to produce %s, got %v", kind, kinds)
+		}
+	}
+
+	encode := func(v any) []byte {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Errorf("Marshal(%v): %v", v, err)
+		}
+		return b
+	}
+	decode := func(b []byte) any {
+		var v any
+		if err := json.Unmarshal(b, &v); err != nil {
+			t.Errorf("Unmarshal(%s): %v", b, err)
+		}
+		return v
+	}
+	lawtest.RoundTripCustom(t, encode, decode, gen, func(a, b any) bool { return reflect.DeepEqual(a, b) })
+}
+
+// treeDepth returns the number of levels in a tree
+func treeDepth[T any](n *lawtest.Node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return 1 + max(treeDepth(n.Left), treeDepth(n.Right))
+}
+
+// flattenRecursive lists tree values in order
+func flattenRecursive(n *lawtest.Node[int]) []int {
+	if n == nil {
+		return []int{}
+	}
+	return append(append(flattenRecursive(n.Left), n.Value), flattenRecursive(n.Right)...)
+}
+
+// flattenIterative lists tree values in order using an explicit stack
+func flattenIterative(n *lawtest.Node[int]) []int {
+	out := []int{}
+	var stack []*lawtest.Node[int]
+	for n != nil || len(stack) > 0 {
+		for ; n != nil; n = n.Left {
+			stack = append(stack, n)
+		}
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		out = append(out, n.Value)
+		n = n.Right
+	}
+	return out
+}
+
+// Test TreeGen respects the depth bound and produces varied shapes
+func TestTreeGen(t *testing.T) {
+	gen := lawtest.TreeGen(lawtest.IntGen(0, 99), 5)
+
+	empty, deepest := false, false
+	for i := 0; i < 500; i++ {
+		tree := gen()
+		d := treeDepth(tree)
+		if d > 5 {
+			t.Fatalf("Tree %v exceeds depth 5", tree)
+		}
+		empty = empty || tree == nil
+		deepest = deepest || d == 5
+	}
+	if !empty || !deepest {
+		t.Errorf("Expected both empty and full-depth trees, got empty=%v deepest=%v", empty, deepest)
+	}
+
+	if tree := lawtest.TreeGen(lawtest.IntGen(0, 99), 0)(); tree != nil {
+		t.Errorf("Expected depth 0 to produce the empty tree, got %v", tree)
+	}
+
+	lawtest.EquivalentCustom(t, flattenRecursive, flattenIterative, gen, slices.Equal[[]int])
+
+	a := &lawtest.Node[int]{Value: 1, Left: &lawtest.Node[int]{Value: 2}}
+	b := &lawtest.Node[int]{Value: 1, Right: &lawtest.Node[int]{Value: 2}}
+	if !lawtest.TreeEqual(a, a) || lawtest.TreeEqual(a, b) || lawtest.TreeEqual(a, nil) || !lawtest.TreeEqual[int](nil, nil) {
+		t.Error("TreeEqual compared trees incorrectly")
+	}
+	if got := a.String(); got != "((_ 2 _) 1 _)" {
+		t.Errorf("Expected ((_ 2 _) 1 _), got %q", got)
+	}
+}
+
+// Test that the Unicode generators draw from their tables
+func TestUnicodeGenerators(t *testing.T) {
+	greek := lawtest.RuneGen(unicode.Greek)
+	for i := 0; i < 200; i++ {
+		if r := greek(); !unicode.Is(unicode.Greek, r) {
+			t.Fatalf("Rune %U is not Greek", r)
+		}
+	}
+
+	gen := lawtest.UnicodeStringGen(8)
+	multibyte, combining := false, false
+	for i := 0; i < 200; i++ {
+		s := gen()
+		if !utf8.ValidString(s) || utf8.RuneCountInString(s) != 8 {
+			t.Fatalf("Expected 8 valid runes, got %q", s)
+		}
+		multibyte = multibyte || len(s) > 8
+		combining = combining || strings.IndexFunc(s, func(r rune) bool { return unicode.Is(unicode.Mn, r) }) >= 0
+	}
+	if !multibyte || !combining {
+		t.Errorf("Expected multibyte runes and combining marks, got multibyte=%v combining=%v", multibyte, combining)
+	}
+
+	upper := func(s string) string { return strings.ToUpper(s) }
+	lawtest.Idempotent(t, upper, lawtest.UnicodeStringGen(6, unicode.Latin, unicode.Cyrillic))
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for no range tables")
+			}
+		}()
+		lawtest.RuneGen()
+	}()
+}
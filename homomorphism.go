@@ -0,0 +1,194 @@
+package lawtest
+
+import "testing"
+
+// ===========================================================================
+// ISOMORPHISMS
+// ===========================================================================
+
+// funcHomomorphism adapts a plain mapping function to the Homomorphism interface.
+type funcHomomorphism[T, U comparable] struct {
+	mapping func(T) U
+	source  Group[T]
+	target  Group[U]
+}
+
+func (h funcHomomorphism[T, U]) Map(x T) U             { return h.mapping(x) }
+func (h funcHomomorphism[T, U]) SourceGroup() Group[T] { return h.source }
+func (h funcHomomorphism[T, U]) TargetGroup() Group[U] { return h.target }
+
+// TestIsomorphism verifies that forward and inverse form a group isomorphism.
+//
+// Tests performed:
+//   - Forward is a homomorphism from g1 to g2
+//   - Inverse is a homomorphism from g2 to g1
+//   - Round trip on g1: inverse(forward(a)) = a
+//   - Round trip on g2: forward(inverse(b)) = b
+//
+// This is useful for confirming that an optimized representation
+// (e.g. a bitset instead of a slice) preserves group structure exactly.
+//
+// Example:
+//
+//	func TestMod4Isomorphism(t *testing.T) {
+//	    // ℤ/4 under addition ≅ {1, i, -1, -i} under multiplication
+//	    forward := func(k int) Quarter { return Quarter(k) }
+//	    inverse := func(q Quarter) int { return int(q) }
+//	    lawtest.TestIsomorphism(t, IntMod4{}, QuarterTurns{}, forward, inverse)
+//	}
+func TestIsomorphism[T, U comparable](t *testing.T, g1 Group[T], g2 Group[U], forward func(T) U, inverse func(U) T) {
+	TestIsomorphismWithConfig(t, g1, g2, forward, inverse, DefaultConfig())
+}
+
+// TestIsomorphismWithConfig verifies a group isomorphism with custom configuration.
+func TestIsomorphismWithConfig[T, U comparable](t *testing.T, g1 Group[T], g2 Group[U], forward func(T) U, inverse func(U) T, cfg *Config) {
+	t.Helper()
+
+	t.Run("Forward", func(t *testing.T) {
+		TestHomomorphismWithConfig[T, U](t, funcHomomorphism[T, U]{forward, g1, g2}, cfg)
+	})
+
+	t.Run("Inverse", func(t *testing.T) {
+		TestHomomorphismWithConfig[U, T](t, funcHomomorphism[U, T]{inverse, g2, g1}, cfg)
+	})
+
+	t.Run("SourceRoundTrip", func(t *testing.T) {
+		checkCases(t, cfg, func(int) string {
+			a := g1.Gen()
+
+			fa := forward(a)
+			back := inverse(fa)
+			if back != a {
+				return cfg.sprintf("Isomorphism round trip failed: inverse(forward(a)) != a\n  a=%v, forward(a)=%v, inverse(forward(a))=%v",
+					a, fa, back)
+			}
+
+			return ""
+		})
+	})
+
+	t.Run("TargetRoundTrip", func(t *testing.T) {
+		checkCases(t, cfg, func(int) string {
+			b := g2.Gen()
+
+			ib := inverse(b)
+			back := forward(ib)
+			if back != b {
+				return cfg.sprintf("Isomorphism round trip failed: forward(inverse(b)) != b\n  b=%v, inverse(b)=%v, forward(inverse(b))=%v",
+					b, ib, back)
+			}
+
+			return ""
+		})
+	})
+}
+
+// ===========================================================================
+// KERNEL
+// ===========================================================================
+
+// Kernel samples the source group and returns the distinct elements that h
+// maps to the target identity.
+//
+// The kernel of a homomorphism always contains the source identity, which is
+// included in the result. A homomorphism is injective exactly when its kernel
+// is trivial, so sampling the kernel is a quick way to check that an intended
+// embedding doesn't collapse distinct elements.
+//
+// Example:
+//
+//	kernel := lawtest.Kernel(Mod12ToMod4{}, 1000)
+//	// kernel ⊆ {0, 4, 8}
+func Kernel[T, U comparable](h Homomorphism[T, U], samples int) []T {
+	src := h.SourceGroup()
+	tgtIdentity := h.TargetGroup().Identity()
+
+	seen := make(map[T]bool)
+	var kernel []T
+
+	add := func(x T) {
+		if !seen[x] && h.Map(x) == tgtIdentity {
+			seen[x] = true
+			kernel = append(kernel, x)
+		}
+	}
+
+	add(src.Identity())
+	for i := 0; i < samples; i++ {
+		add(src.Gen())
+	}
+
+	return kernel
+}
+
+// IsInjectiveHomomorphism reports whether the sampled kernel of h is trivial,
+// i.e. no sampled element other than the source identity maps to the target
+// identity.
+//
+// Sampling can only find counterexamples: true means no non-trivial kernel
+// element was found in samples draws, not that h is proven injective.
+//
+// Example:
+//
+//	if !lawtest.IsInjectiveHomomorphism(embed, 1000) {
+//	    t.Error("embedding collapses distinct elements")
+//	}
+func IsInjectiveHomomorphism[T, U comparable](h Homomorphism[T, U], samples int) bool {
+	srcIdentity := h.SourceGroup().Identity()
+
+	for _, x := range Kernel(h, samples) {
+		if x != srcIdentity {
+			return false
+		}
+	}
+	return true
+}
+
+// ===========================================================================
+// AGREEING IMPLEMENTATIONS
+// ===========================================================================
+
+// GroupsAgree tests that two implementations of a group over the same
+// element type are observationally identical: the same identity, and the
+// same Op and Inverse results on sampled elements.
+//
+// It is the refactoring safety net for Group implementations, where
+// TestIsomorphism would need a trivial mapping. Only gen is sampled, so
+// g1.Gen and g2.Gen may differ.
+//
+// Example:
+//
+//	func TestFastModGroup(t *testing.T) {
+//	    lawtest.GroupsAgree[int](t, ModGroup{n: 12}, FastModGroup{n: 12}, lawtest.IntGen(0, 11))
+//	}
+//
+// Returns true if the groups agreed on every sample.
+func GroupsAgree[T comparable](t TB, g1, g2 Group[T], gen Generator[T]) bool {
+	return GroupsAgreeWithConfig(t, g1, g2, gen, DefaultConfig())
+}
+
+// GroupsAgreeWithConfig tests that two group implementations agree, with custom configuration.
+func GroupsAgreeWithConfig[T comparable](t TB, g1, g2 Group[T], gen Generator[T], cfg *Config) bool {
+	t.Helper()
+
+	return checkCases(t, cfg, func(int) string {
+		if e1, e2 := g1.Identity(), g2.Identity(); e1 != e2 {
+			return cfg.sprintf("Groups disagree on Identity\n  g1.Identity()=%v, g2.Identity()=%v",
+				e1, e2)
+		}
+
+		a, b := gen(), gen()
+
+		if ab1, ab2 := g1.Op(a, b), g2.Op(a, b); ab1 != ab2 {
+			return cfg.sprintf("Groups disagree on Op\n  a=%v, b=%v\n  g1.Op(a, b)=%v, g2.Op(a, b)=%v",
+				a, b, ab1, ab2)
+		}
+
+		if inv1, inv2 := g1.Inverse(a), g2.Inverse(a); inv1 != inv2 {
+			return cfg.sprintf("Groups disagree on Inverse\n  a=%v\n  g1.Inverse(a)=%v, g2.Inverse(a)=%v",
+				a, inv1, inv2)
+		}
+
+		return ""
+	})
+}
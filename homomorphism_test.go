@@ -0,0 +1,152 @@
+package lawtest_test
+
+import (
+	"maps"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Subsets of {0..3} as a bool array under symmetric difference
+type BoolSetGroup struct{}
+
+func (g BoolSetGroup) Op(a, b [4]bool) [4]bool {
+	var r [4]bool
+	for i := range r {
+		r[i] = a[i] != b[i]
+	}
+	return r
+}
+func (g BoolSetGroup) Identity() [4]bool         { return [4]bool{} }
+func (g BoolSetGroup) Inverse(a [4]bool) [4]bool { return a }
+func (g BoolSetGroup) Gen() [4]bool {
+	return [4]bool{rand.Intn(2) == 1, rand.Intn(2) == 1, rand.Intn(2) == 1, rand.Intn(2) == 1}
+}
+
+// Subsets of {0..3} as a bitset under XOR
+type BitsetGroup struct{}
+
+func (g BitsetGroup) Op(a, b uint8) uint8   { return a ^ b }
+func (g BitsetGroup) Identity() uint8       { return 0 }
+func (g BitsetGroup) Inverse(a uint8) uint8 { return a }
+func (g BitsetGroup) Gen() uint8            { return uint8(rand.Intn(16)) }
+
+func TestBitsetIsomorphism(t *testing.T) {
+	toBits := func(s [4]bool) uint8 {
+		var b uint8
+		for i, in := range s {
+			if in {
+				b |= 1 << i
+			}
+		}
+		return b
+	}
+	fromBits := func(b uint8) [4]bool {
+		var s [4]bool
+		for i := range s {
+			s[i] = b&(1<<i) != 0
+		}
+		return s
+	}
+
+	lawtest.TestIsomorphism[[4]bool, uint8](t, BoolSetGroup{}, BitsetGroup{}, toBits, fromBits)
+}
+
+// Integers mod n under addition
+type ModGroup struct{ n int }
+
+func (g ModGroup) Op(a, b int) int   { return (a + b) % g.n }
+func (g ModGroup) Identity() int     { return 0 }
+func (g ModGroup) Inverse(a int) int { return (g.n - a) % g.n }
+func (g ModGroup) Gen() int          { return rand.Intn(g.n) }
+
+// Reduction ℤ/12 → ℤ/4
+type Mod12ToMod4 struct{}
+
+func (h Mod12ToMod4) Map(x int) int                   { return x % 4 }
+func (h Mod12ToMod4) SourceGroup() lawtest.Group[int] { return ModGroup{12} }
+func (h Mod12ToMod4) TargetGroup() lawtest.Group[int] { return ModGroup{4} }
+
+// Embedding ℤ/4 → ℤ/12
+type Mod4ToMod12 struct{}
+
+func (h Mod4ToMod12) Map(x int) int                   { return 3 * x }
+func (h Mod4ToMod12) SourceGroup() lawtest.Group[int] { return ModGroup{4} }
+func (h Mod4ToMod12) TargetGroup() lawtest.Group[int] { return ModGroup{12} }
+
+func TestKernel(t *testing.T) {
+	t.Run("Reduction", func(t *testing.T) {
+		lawtest.TestHomomorphism[int, int](t, Mod12ToMod4{})
+
+		kernel := lawtest.Kernel[int, int](Mod12ToMod4{}, 1000)
+		if len(kernel) != 3 {
+			t.Errorf("Expected kernel {0, 4, 8}, got %v", kernel)
+		}
+		for _, x := range kernel {
+			if x%4 != 0 {
+				t.Errorf("Unexpected kernel element %d", x)
+			}
+		}
+
+		if lawtest.IsInjectiveHomomorphism[int, int](Mod12ToMod4{}, 1000) {
+			t.Error("Expected reduction mod 4 to be non-injective")
+		}
+	})
+
+	t.Run("Embedding", func(t *testing.T) {
+		lawtest.TestHomomorphism[int, int](t, Mod4ToMod12{})
+
+		if !lawtest.IsInjectiveHomomorphism[int, int](Mod4ToMod12{}, 1000) {
+			t.Errorf("Expected embedding to be injective, kernel=%v",
+				lawtest.Kernel[int, int](Mod4ToMod12{}, 1000))
+		}
+	})
+}
+
+// bitSet maps a bitmask to the set of its bit positions
+func bitSet(n int) map[int]bool {
+	set := map[int]bool{}
+	for i := 0; n>>i != 0; i++ {
+		if n>>i&1 == 1 {
+			set[i] = true
+		}
+	}
+	return set
+}
+
+func union(a, b map[int]bool) map[int]bool {
+	set := maps.Clone(a)
+	maps.Copy(set, b)
+	return set
+}
+
+func TestHomomorphismCustom(t *testing.T) {
+	or := func(a, b int) int { return a | b }
+	gen := lawtest.IntGen(0, 255)
+
+	// Bitmasks under OR → bit position sets under union
+	lawtest.TestHomomorphismCustom(t, bitSet, or, 0, union, map[int]bool{}, gen, maps.Equal[map[int]bool, map[int]bool])
+}
+
+// Subsets of {0..3} as a bitset, with XOR spelled out
+type SpelledXorGroup struct{ BitsetGroup }
+
+func (g SpelledXorGroup) Op(a, b uint8) uint8 { return (a | b) &^ (a & b) }
+
+// Test that interchangeable group implementations agree and different ones don't
+func TestGroupsAgree(t *testing.T) {
+	gen := lawtest.Map(lawtest.IntGen(0, 15), func(n int) uint8 { return uint8(n) })
+	if !lawtest.GroupsAgree[uint8](t, BitsetGroup{}, SpelledXorGroup{}, gen) {
+		t.Error("Expected both spellings of XOR to agree")
+	}
+
+	log := &failureLog{}
+	if lawtest.GroupsAgree[int](log, IntModGroup{modulus: 12}, IntModGroup{modulus: 13}, lawtest.IntGen(0, 11)) {
+		t.Error("Expected addition mod 12 and mod 13 to disagree")
+	}
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Groups disagree on") {
+		t.Errorf("Expected a disagreement, got %q", log.errors)
+	}
+}
@@ -0,0 +1,1205 @@
+package lawtest
+
+import "cmp"
+
+// ===========================================================================
+// CANCELLATION LAWS
+// ===========================================================================
+
+// LeftCancellative tests left cancellation: a ∘ b = a ∘ c implies b = c.
+//
+// Cancellation is weaker than invertibility: every group is cancellative,
+// but so are the positive integers under addition, which have no inverses.
+//
+// Random triples rarely collide, so for each a and b the test searches the
+// generator for a c with a ∘ c = a ∘ b and asserts that c = b.
+//
+// Example:
+//
+//	func TestAdditionCancellative(t *testing.T) {
+//	    add := func(a, b int) int { return a + b }
+//	    gen := lawtest.IntGen(1, 20)
+//	    lawtest.LeftCancellative(t, add, gen)
+//	}
+func LeftCancellative[T comparable](t TB, op BinaryOp[T], gen Generator[T]) {
+	LeftCancellativeWithConfig(t, op, gen, DefaultConfig())
+}
+
+// LeftCancellativeWithConfig tests left cancellation with custom configuration.
+func LeftCancellativeWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+		ab := op(a, b)
+
+		for j := 0; j < searchCandidates; j++ {
+			c := gen()
+			if c == b {
+				continue
+			}
+
+			ac := op(a, c)
+			if ac == ab {
+				return cfg.sprintf("Left cancellation failed: a∘b = a∘c but b != c\n  a=%v, b=%v, c=%v\n  a∘b=%v, a∘c=%v",
+					a, b, c, ab, ac)
+			}
+		}
+
+		return ""
+	})
+}
+
+// RightCancellative tests right cancellation: b ∘ a = c ∘ a implies b = c.
+//
+// Example:
+//
+//	func TestMultiplicationCancellative(t *testing.T) {
+//	    mul := func(a, b int) int { return a * b }
+//	    gen := lawtest.IntGen(1, 20) // zero would break cancellation
+//	    lawtest.RightCancellative(t, mul, gen)
+//	}
+func RightCancellative[T comparable](t TB, op BinaryOp[T], gen Generator[T]) {
+	RightCancellativeWithConfig(t, op, gen, DefaultConfig())
+}
+
+// RightCancellativeWithConfig tests right cancellation with custom configuration.
+func RightCancellativeWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+		ba := op(b, a)
+
+		for j := 0; j < searchCandidates; j++ {
+			c := gen()
+			if c == b {
+				continue
+			}
+
+			ca := op(c, a)
+			if ca == ba {
+				return cfg.sprintf("Right cancellation failed: b∘a = c∘a but b != c\n  a=%v, b=%v, c=%v\n  b∘a=%v, c∘a=%v",
+					a, b, c, ba, ca)
+			}
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// ONE-SIDED IDENTITIES
+// ===========================================================================
+
+// LeftIdentity tests only the first half of Identity: a ∘ e = a.
+//
+// Use it for structures where e is neutral on one side only. See One-Sided
+// Laws in the package documentation for how sides are named.
+//
+// Example:
+//
+//	func TestFirstLeftIdentity(t *testing.T) {
+//	    first := func(a, b int) int { return a } // left-zero semigroup
+//	    lawtest.LeftIdentity(t, first, 0, lawtest.IntGen(-100, 100))
+//	}
+func LeftIdentity[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T]) {
+	LeftIdentityWithConfig(t, op, identity, gen, DefaultConfig())
+}
+
+// LeftIdentityWithConfig tests a left identity with custom configuration.
+func LeftIdentityWithConfig[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		result := op(a, identity)
+		if result != a {
+			return cfg.sprintf("Left identity failed: a∘e != a\n  a=%v, e=%v, a∘e=%v",
+				a, identity, result)
+		}
+
+		return ""
+	})
+}
+
+// RightIdentity tests only the second half of Identity: e ∘ a = a.
+//
+// Example:
+//
+//	func TestSecondRightIdentity(t *testing.T) {
+//	    second := func(a, b int) int { return b } // right-zero semigroup
+//	    lawtest.RightIdentity(t, second, 0, lawtest.IntGen(-100, 100))
+//	}
+func RightIdentity[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T]) {
+	RightIdentityWithConfig(t, op, identity, gen, DefaultConfig())
+}
+
+// RightIdentityWithConfig tests a right identity with custom configuration.
+func RightIdentityWithConfig[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		result := op(identity, a)
+		if result != a {
+			return cfg.sprintf("Right identity failed: e∘a != a\n  e=%v, a=%v, e∘a=%v",
+				identity, a, result)
+		}
+
+		return ""
+	})
+}
+
+// TestIdentityUnique verifies that no value other than claimedIdentity acts
+// as an identity: if a ∘ e' = a or e' ∘ a = a for every sampled a, then
+// e' = e.
+//
+// A two-sided identity is unique, so a second element that is neutral on
+// either side means e itself is wrong, for instance neutral on one side
+// only, with the real identity being some other value. Identity checks e
+// alone and can't see this. Each case draws a candidate e' from gen and
+// tries it against e and 20 further values; including e makes
+// every reported candidate a proof that e is not a two-sided identity.
+//
+// Example:
+//
+//	func TestMergeIdentityUnique(t *testing.T) {
+//	    lawtest.TestIdentityUnique(t, merge, EmptyConfig, genConfig)
+//	}
+//	// Identity not unique: e' != e is also neutral (a∘e' = a for every sampled a)
+func TestIdentityUnique[T comparable](t TB, op BinaryOp[T], claimedIdentity T, gen Generator[T]) {
+	TestIdentityUniqueWithConfig(t, op, claimedIdentity, gen, DefaultConfig())
+}
+
+// TestIdentityUniqueWithConfig verifies identity uniqueness with custom configuration.
+func TestIdentityUniqueWithConfig[T comparable](t TB, op BinaryOp[T], claimedIdentity T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		candidate := gen()
+		if candidate == claimedIdentity {
+			return ""
+		}
+
+		samples := []T{claimedIdentity}
+		for j := 0; j < searchCandidates; j++ {
+			samples = append(samples, gen())
+		}
+
+		rightNeutral, leftNeutral := true, true
+		for _, a := range samples {
+			rightNeutral = rightNeutral && op(a, candidate) == a
+			leftNeutral = leftNeutral && op(candidate, a) == a
+		}
+
+		var side string
+		switch {
+		case rightNeutral && leftNeutral:
+			side = "a∘e' = a and e'∘a = a"
+		case rightNeutral:
+			side = "a∘e' = a"
+		case leftNeutral:
+			side = "e'∘a = a"
+		default:
+			return ""
+		}
+
+		return cfg.sprintf("Identity not unique: e' != e is also neutral (%s for every sampled a)\n  e=%v, e'=%v, e∘e'=%v, e'∘e=%v",
+			side, claimedIdentity, candidate, op(claimedIdentity, candidate), op(candidate, claimedIdentity))
+	})
+}
+
+// ===========================================================================
+// ABSORBING ELEMENTS
+// ===========================================================================
+
+// Absorbing tests if zero is an absorbing element: a ∘ 0 = 0 and 0 ∘ a = 0.
+//
+// An absorbing element swallows anything it is combined with, the opposite
+// of an identity. Rings and semirings usually have both.
+//
+// Example:
+//
+//	func TestMultiplicationZero(t *testing.T) {
+//	    mul := func(a, b int) int { return a * b }
+//	    lawtest.Absorbing(t, mul, 0, lawtest.IntGen(-100, 100))
+//	}
+//
+// Common absorbing elements:
+//   - Multiplication: 0 (a * 0 = 0)
+//   - Boolean AND: false (b && false = false)
+//   - Min: -∞ (min(a, -∞) = -∞)
+//   - Set intersection: ∅ (A ∩ ∅ = ∅)
+func Absorbing[T comparable](t TB, op BinaryOp[T], zero T, gen Generator[T]) {
+	AbsorbingWithConfig(t, op, zero, gen, DefaultConfig())
+}
+
+// AbsorbingWithConfig tests an absorbing element with custom configuration.
+func AbsorbingWithConfig[T comparable](t TB, op BinaryOp[T], zero T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		// a ∘ 0 = 0
+		leftResult := op(a, zero)
+		if leftResult != zero {
+			return cfg.sprintf("Absorption failed: a∘0 != 0\n  a=%v, 0=%v, a∘0=%v",
+				a, zero, leftResult)
+		}
+
+		// 0 ∘ a = 0
+		rightResult := op(zero, a)
+		if rightResult != zero {
+			return cfg.sprintf("Absorption failed: 0∘a != 0\n  0=%v, a=%v, 0∘a=%v",
+				zero, a, rightResult)
+		}
+
+		return ""
+	})
+}
+
+// LeftAbsorbing tests if zero is a left zero, absorbing anything combined
+// with it from the right: 0 ∘ a = 0.
+//
+// Use it with RightAbsorbing to describe one-sided absorbers, which
+// Absorbing rejects. See One-Sided Laws in the package documentation for
+// how sides are named.
+//
+// Example:
+//
+//	func TestFirstLeftZero(t *testing.T) {
+//	    first := func(a, b int) int { return a } // every element is a left zero
+//	    lawtest.LeftAbsorbing(t, first, 7, lawtest.IntGen(-100, 100))
+//	}
+func LeftAbsorbing[T comparable](t TB, op BinaryOp[T], zero T, gen Generator[T]) {
+	LeftAbsorbingWithConfig(t, op, zero, gen, DefaultConfig())
+}
+
+// LeftAbsorbingWithConfig tests a left zero with custom configuration.
+func LeftAbsorbingWithConfig[T comparable](t TB, op BinaryOp[T], zero T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		result := op(zero, a)
+		if result != zero {
+			return cfg.sprintf("Left zero failed: 0∘a != 0\n  0=%v, a=%v, 0∘a=%v",
+				zero, a, result)
+		}
+
+		return ""
+	})
+}
+
+// RightAbsorbing tests if zero is a right zero, absorbing anything combined
+// with it from the left: a ∘ 0 = 0. See LeftAbsorbing.
+//
+// Example:
+//
+//	func TestSecondRightZero(t *testing.T) {
+//	    second := func(a, b int) int { return b } // every element is a right zero
+//	    lawtest.RightAbsorbing(t, second, 7, lawtest.IntGen(-100, 100))
+//	}
+func RightAbsorbing[T comparable](t TB, op BinaryOp[T], zero T, gen Generator[T]) {
+	RightAbsorbingWithConfig(t, op, zero, gen, DefaultConfig())
+}
+
+// RightAbsorbingWithConfig tests a right zero with custom configuration.
+func RightAbsorbingWithConfig[T comparable](t TB, op BinaryOp[T], zero T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		result := op(a, zero)
+		if result != zero {
+			return cfg.sprintf("Right zero failed: a∘0 != 0\n  a=%v, 0=%v, a∘0=%v",
+				a, zero, result)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// INVOLUTION
+// ===========================================================================
+
+// Involution tests if applying an operation twice restores the input: f(f(x)) = x.
+//
+// Involutions are their own inverse. Unlike idempotence, where f(f(x)) = f(x),
+// an involution must undo itself.
+//
+// Example:
+//
+//	func TestNegationInvolution(t *testing.T) {
+//	    negate := func(x int) int { return -x }
+//	    gen := lawtest.IntGen(-100, 100)
+//	    lawtest.Involution(t, negate, gen)
+//	}
+//
+// Common involutions:
+//   - Negation: -(-x) = x
+//   - Bitwise NOT: ^^x = x
+//   - List reversal: reverse(reverse(l)) = l
+//   - Matrix transpose: (Mᵀ)ᵀ = M
+func Involution[T comparable](t TB, op UnaryOp[T], gen Generator[T]) {
+	InvolutionWithConfig(t, op, gen, DefaultConfig())
+}
+
+// InvolutionWithConfig tests involution with custom configuration.
+func InvolutionWithConfig[T comparable](t TB, op UnaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		x := gen()
+
+		fx := op(x)
+		ffx := op(fx)
+
+		if ffx != x {
+			return cfg.sprintf("Involution failed: f(f(x)) != x\n  x=%v, f(x)=%v, f(f(x))=%v",
+				x, fx, ffx)
+		}
+
+		return ""
+	})
+}
+
+// InvolutionCustom tests involution using a custom equality function.
+// Use this for non-comparable types (slices, maps, functions).
+//
+// Example:
+//
+//	reverse := func(s []int) []int {
+//	    r := make([]int, len(s))
+//	    for i := range s {
+//	        r[len(s)-1-i] = s[i]
+//	    }
+//	    return r
+//	}
+//	gen := func() []int { return []int{rand.Intn(10), rand.Intn(10), rand.Intn(10)} }
+//	eq := func(a, b []int) bool { return reflect.DeepEqual(a, b) }
+//	lawtest.InvolutionCustom(t, reverse, gen, eq)
+func InvolutionCustom[T any](t TB, op UnaryOp[T], gen Generator[T], eq func(T, T) bool) {
+	InvolutionCustomWithConfig(t, op, gen, eq, DefaultConfig())
+}
+
+// InvolutionCustomWithConfig tests involution with custom equality and configuration.
+func InvolutionCustomWithConfig[T any](t TB, op UnaryOp[T], gen Generator[T], eq func(T, T) bool, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		x := gen()
+
+		fx := op(x)
+		ffx := op(fx)
+
+		if !eq(ffx, x) {
+			return cfg.sprintf("Involution failed: f(f(x)) != x\n  x=%v, f(x)=%v, f(f(x))=%v",
+				x, fx, ffx) + cfg.diff(ffx, x)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// ROUND TRIPS
+// ===========================================================================
+
+// RoundTrip tests if decode undoes encode: decode(encode(x)) = x.
+//
+// Encoders and decoders, serializers and parsers, compressors and
+// decompressors must all round-trip. Only this direction is checked: an
+// encoding may have several representations of the same value, so
+// encode(decode(r)) = r need not hold.
+//
+// Example:
+//
+//	func TestItoaRoundTrip(t *testing.T) {
+//	    parse := func(s string) int { n, _ := strconv.Atoi(s); return n }
+//	    lawtest.RoundTrip(t, strconv.Itoa, parse, lawtest.IntGen(-1e9, 1e9))
+//	}
+func RoundTrip[T comparable, R any](t TB, encode func(T) R, decode func(R) T, gen Generator[T]) {
+	RoundTripWithConfig(t, encode, decode, gen, DefaultConfig())
+}
+
+// RoundTripWithConfig tests a round trip with custom configuration.
+func RoundTripWithConfig[T comparable, R any](t TB, encode func(T) R, decode func(R) T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	RoundTripCustomWithConfig(t, encode, decode, gen, func(a, b T) bool { return a == b }, cfg)
+}
+
+// RoundTripCustom tests a round trip using a custom equality function.
+// Use this for non-comparable types (slices, maps, structs containing them).
+//
+// Example:
+//
+//	encode := func(m map[string]int) []byte { b, _ := json.Marshal(m); return b }
+//	decode := func(b []byte) map[string]int { var m map[string]int; json.Unmarshal(b, &m); return m }
+//	gen := lawtest.MapGen(lawtest.StringGen(5), lawtest.IntGen(-100, 100), 1, 10)
+//	lawtest.RoundTripCustom(t, encode, decode, gen, maps.Equal[map[string]int, map[string]int])
+func RoundTripCustom[T, R any](t TB, encode func(T) R, decode func(R) T, gen Generator[T], eq func(T, T) bool) {
+	RoundTripCustomWithConfig(t, encode, decode, gen, eq, DefaultConfig())
+}
+
+// RoundTripCustomWithConfig tests a round trip with custom equality and configuration.
+func RoundTripCustomWithConfig[T, R any](t TB, encode func(T) R, decode func(R) T, gen Generator[T], eq func(T, T) bool, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		x := gen()
+
+		encoded := encode(x)
+		decoded := decode(encoded)
+
+		if !eq(decoded, x) {
+			return cfg.sprintf("Round trip failed: decode(encode(x)) != x\n  x=%v, encode(x)=%v, decode(encode(x))=%v",
+				x, encoded, decoded) + cfg.diff(decoded, x)
+		}
+
+		return ""
+	})
+}
+
+// Bijective tests if f and g are inverse bijections: g(f(x)) = x for all
+// x in T and f(g(y)) = y for all y in R.
+//
+// Unlike RoundTrip, both directions are checked, each with its own
+// generator, so a codec with several encodings of the same value (or
+// encodings that decode to nothing sensible) fails. Together the two
+// directions prove f is a bijection with inverse g on the generated values.
+//
+// Example:
+//
+//	func TestCaesarCipher(t *testing.T) {
+//	    shift := func(r rune) rune { return 'a' + (r-'a'+3)%26 }
+//	    unshift := func(r rune) rune { return 'a' + (r-'a'+23)%26 }
+//	    gen := lawtest.Map(lawtest.IntGen(0, 25), func(n int) rune { return 'a' + rune(n) })
+//	    lawtest.Bijective(t, shift, unshift, gen, gen)
+//	}
+func Bijective[T, R comparable](t TB, f func(T) R, g func(R) T, genT Generator[T], genR Generator[R]) {
+	BijectiveWithConfig(t, f, g, genT, genR, DefaultConfig())
+}
+
+// BijectiveWithConfig tests a bijection with custom configuration.
+func BijectiveWithConfig[T, R comparable](t TB, f func(T) R, g func(R) T, genT Generator[T], genR Generator[R], cfg *Config) {
+	t.Helper()
+
+	BijectiveCustomWithConfig(t, f, g, genT, genR,
+		func(a, b T) bool { return a == b }, func(a, b R) bool { return a == b }, cfg)
+}
+
+// BijectiveCustom tests a bijection using custom equality functions for
+// each side. Use this for non-comparable types (slices, maps, functions).
+//
+// Example:
+//
+//	toBytes := func(s string) []byte { return []byte(s) }
+//	toString := func(b []byte) string { return string(b) }
+//	strEq := func(a, b string) bool { return a == b }
+//	lawtest.BijectiveCustom(t, toBytes, toString, lawtest.StringGen(10), genBytes, strEq, bytes.Equal)
+func BijectiveCustom[T, R any](t TB, f func(T) R, g func(R) T, genT Generator[T], genR Generator[R], eqT func(T, T) bool, eqR func(R, R) bool) {
+	BijectiveCustomWithConfig(t, f, g, genT, genR, eqT, eqR, DefaultConfig())
+}
+
+// BijectiveCustomWithConfig tests a bijection with custom equality and configuration.
+func BijectiveCustomWithConfig[T, R any](t TB, f func(T) R, g func(R) T, genT Generator[T], genR Generator[R], eqT func(T, T) bool, eqR func(R, R) bool, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		x, y := genT(), genR()
+
+		// g(f(x)) = x
+		fx := f(x)
+		gfx := g(fx)
+		if !eqT(gfx, x) {
+			return cfg.sprintf("Bijection failed: g(f(x)) != x\n  x=%v, f(x)=%v, g(f(x))=%v",
+				x, fx, gfx)
+		}
+
+		// f(g(y)) = y
+		gy := g(y)
+		fgy := f(gy)
+		if !eqR(fgy, y) {
+			return cfg.sprintf("Bijection failed: f(g(y)) != y\n  y=%v, g(y)=%v, f(g(y))=%v",
+				y, gy, fgy)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// FIXPOINTS
+// ===========================================================================
+
+// FixpointConverges tests if repeatedly applying an operation reaches a
+// fixpoint: for every x there is an n ≤ maxIters with f(fⁿ(x)) = fⁿ(x).
+//
+// Idempotent only compares two applications, which can pass by luck when the
+// generator rarely produces inputs that change. FixpointConverges follows each
+// input until it stabilizes and logs the largest number of applications any
+// input needed; an idempotent f never needs more than one. An input that does
+// not converge is reported with its trajectory, or with the cycle it
+// oscillates through if it revisits a value.
+//
+// Example:
+//
+//	func TestEscapeConverges(t *testing.T) {
+//	    escape := func(s string) string { return strings.ReplaceAll(s, `"`, `\"`) }
+//	    quoted := lawtest.Map(lawtest.StringGen(5), strconv.Quote)
+//	    lawtest.FixpointConverges(t, escape, quoted, 3)
+//	}
+//	// Fixpoint convergence failed: no fixpoint within 3 applications
+//	//   x="ab", trajectory: ["ab" \"ab\" \\"ab\\" \\\"ab\\\" \\\\"ab\\\\"]
+//
+// Panics if maxIters < 0.
+func FixpointConverges[T comparable](t TB, op UnaryOp[T], gen Generator[T], maxIters int) {
+	FixpointConvergesWithConfig(t, op, gen, maxIters, DefaultConfig())
+}
+
+// FixpointConvergesWithConfig tests fixpoint convergence with custom configuration.
+func FixpointConvergesWithConfig[T comparable](t TB, op UnaryOp[T], gen Generator[T], maxIters int, cfg *Config) {
+	t.Helper()
+
+	if maxIters < 0 {
+		panic(cfg.sprintf("maxIters (%d) must be >= 0", maxIters))
+	}
+
+	var most int
+	passed := checkCases(t, cfg, func(int) string {
+		x := gen()
+
+		trajectory := []T{x}
+		seen := map[T]int{x: 0}
+		for n := 0; n <= maxIters; n++ {
+			cur := trajectory[n]
+			next := op(cur)
+
+			if next == cur {
+				most = max(most, n)
+				return ""
+			}
+
+			if j, ok := seen[next]; ok {
+				return cfg.sprintf("Fixpoint convergence failed: f oscillates with period %d\n  x=%v, cycle: %v",
+					n+1-j, x, trajectory[j:])
+			}
+
+			seen[next] = n + 1
+			trajectory = append(trajectory, next)
+		}
+
+		return cfg.sprintf("Fixpoint convergence failed: no fixpoint within %d applications\n  x=%v, trajectory: %v",
+			maxIters, x, trajectory)
+	})
+
+	if passed {
+		t.Logf("lawtest: every input reached a fixpoint within %d applications", most)
+	}
+}
+
+// ===========================================================================
+// MONOTONICITY
+// ===========================================================================
+
+// Monotonic tests if a function preserves (or reverses) order.
+//
+// With increasing set, it verifies a ≤ b implies f(a) ≤ f(b) (non-decreasing).
+// Otherwise it verifies a ≤ b implies f(a) ≥ f(b) (non-increasing).
+//
+// Example:
+//
+//	func TestBucketMonotonic(t *testing.T) {
+//	    bucket := func(x int) int { return x / 10 }
+//	    gen := lawtest.IntGen(0, 1000)
+//	    lawtest.Monotonic(t, bucket, gen, true)
+//	}
+//
+// Useful for hash bucketing, rate-limit curves, and scoring functions.
+func Monotonic[T cmp.Ordered](t TB, f UnaryOp[T], gen Generator[T], increasing bool) {
+	MonotonicWithConfig(t, f, gen, increasing, DefaultConfig())
+}
+
+// MonotonicWithConfig tests monotonicity with custom configuration.
+func MonotonicWithConfig[T cmp.Ordered](t TB, f UnaryOp[T], gen Generator[T], increasing bool, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+		if b < a {
+			a, b = b, a
+		}
+
+		fa := f(a)
+		fb := f(b)
+
+		if increasing && fa > fb {
+			return cfg.sprintf("Monotonicity failed: a ≤ b but f(a) > f(b)\n  a=%v, b=%v\n  f(a)=%v, f(b)=%v",
+				a, b, fa, fb)
+		}
+
+		if !increasing && fa < fb {
+			return cfg.sprintf("Monotonicity failed: a ≤ b but f(a) < f(b)\n  a=%v, b=%v\n  f(a)=%v, f(b)=%v",
+				a, b, fa, fb)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// DISTRIBUTIVITY
+// ===========================================================================
+
+// Distributive tests if mul distributes over add from both sides:
+// a ⊗ (b ⊕ c) = (a ⊗ b) ⊕ (a ⊗ c) and (a ⊕ b) ⊗ c = (a ⊗ c) ⊕ (b ⊗ c).
+//
+// Example:
+//
+//	func TestIntegerDistributive(t *testing.T) {
+//	    add := func(a, b int) int { return a + b }
+//	    mul := func(a, b int) int { return a * b }
+//	    lawtest.Distributive(t, mul, add, lawtest.IntGen(-100, 100))
+//	}
+//
+// Common distributive pairs:
+//   - Multiplication over addition
+//   - AND over OR, and OR over AND
+//   - Intersection over union, and union over intersection
+//   - Min over max, and max over min
+func Distributive[T comparable](t TB, mul, add BinaryOp[T], gen Generator[T]) {
+	DistributiveWithConfig(t, mul, add, gen, DefaultConfig())
+}
+
+// DistributiveWithConfig tests distributivity with custom configuration.
+func DistributiveWithConfig[T comparable](t TB, mul, add BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		v := drawDistinct(cfg, gen, 3)
+		a, b, c := v[0], v[1], v[2]
+
+		// a ⊗ (b ⊕ c) = (a ⊗ b) ⊕ (a ⊗ c)
+		left := mul(a, add(b, c))
+		right := add(mul(a, b), mul(a, c))
+		if left != right {
+			return cfg.sprintf("Left distributivity failed: a⊗(b⊕c) != (a⊗b)⊕(a⊗c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
+				a, b, c, left, right)
+		}
+
+		// (a ⊕ b) ⊗ c = (a ⊗ c) ⊕ (b ⊗ c)
+		left = mul(add(a, b), c)
+		right = add(mul(a, c), mul(b, c))
+		if left != right {
+			return cfg.sprintf("Right distributivity failed: (a⊕b)⊗c != (a⊗c)⊕(b⊗c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
+				a, b, c, left, right)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// MULTIPLICATIVE INVERSES
+// ===========================================================================
+
+// MultiplicativeInverse tests if every nonzero element has an inverse under
+// mul: a · a⁻¹ = a⁻¹ · a = one for all generated a ≠ zero.
+//
+// Unlike Inverse, zero is exempt: inv is never called with it, and
+// generated zeros are skipped. The test fails if zero = one, since then the
+// structure is trivial, or if gen produced nothing but zeros.
+//
+// Example:
+//
+//	func TestGF7Inverses(t *testing.T) {
+//	    mul := func(a, b int) int { return a * b % 7 }
+//	    inv := func(a int) int { return lawtest.Power(mul, 1, a, 5) }
+//	    lawtest.MultiplicativeInverse(t, mul, inv, 0, 1, lawtest.IntGen(0, 6))
+//	}
+func MultiplicativeInverse[T comparable](t TB, mul BinaryOp[T], inv UnaryOp[T], zero, one T, gen Generator[T]) {
+	MultiplicativeInverseWithConfig(t, mul, inv, zero, one, gen, DefaultConfig())
+}
+
+// MultiplicativeInverseWithConfig tests multiplicative inverses with custom configuration.
+func MultiplicativeInverseWithConfig[T comparable](t TB, mul BinaryOp[T], inv UnaryOp[T], zero, one T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	if zero == one {
+		t.Errorf("Multiplicative inverse failed: 0 = 1, so the structure is trivial\n  0=%v, 1=%v", zero, one)
+		return
+	}
+
+	cfg = pinSeed(t, cfg)
+	logIgnoredOptions(t, cfg)
+
+	var zeros int
+	run := runSeeded(cfg, func(int) string {
+		a := gen()
+		if a == zero {
+			zeros++
+			return ""
+		}
+
+		aInv := inv(a)
+
+		// a · a⁻¹ = 1
+		if left := mul(a, aInv); left != one {
+			return cfg.sprintf("Multiplicative inverse failed: a·a⁻¹ != 1\n  a=%v, a⁻¹=%v, a·a⁻¹=%v",
+				a, aInv, left)
+		}
+
+		// a⁻¹ · a = 1
+		if right := mul(aInv, a); right != one {
+			return cfg.sprintf("Multiplicative inverse failed: a⁻¹·a != 1\n  a⁻¹=%v, a=%v, a⁻¹·a=%v",
+				aInv, a, right)
+		}
+
+		return ""
+	})
+
+	if run.report(t, cfg) && zeros == cfg.TestCases {
+		t.Errorf("Multiplicative inverse failed: gen produced only zeros in %d cases, so no inverse was checked\n  %s",
+			zeros, run.reproduce())
+	}
+}
+
+// ===========================================================================
+// LATTICE LAWS
+// ===========================================================================
+
+// BinaryIdempotent tests if combining a value with itself gives it back: a ∘ a = a.
+//
+// This is the idempotence of semilattice operations, as opposed to
+// Idempotent, which checks f(f(x)) = f(x) for a unary function.
+//
+// Example:
+//
+//	func TestMaxIdempotent(t *testing.T) {
+//	    join := func(a, b int) int { return max(a, b) }
+//	    lawtest.BinaryIdempotent(t, join, lawtest.IntGen(-100, 100))
+//	}
+//
+// Common examples:
+//   - Ordered values: max and min
+//   - Sets: union and intersection
+//   - Bitmasks: OR and AND
+func BinaryIdempotent[T comparable](t TB, op BinaryOp[T], gen Generator[T]) {
+	BinaryIdempotentWithConfig(t, op, gen, DefaultConfig())
+}
+
+// BinaryIdempotentWithConfig tests binary idempotence with custom configuration.
+func BinaryIdempotentWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		result := op(a, a)
+		if result != a {
+			return cfg.sprintf("Idempotence failed: a∘a != a\n  a=%v, a∘a=%v", a, result)
+		}
+
+		return ""
+	})
+}
+
+// Absorption tests the lattice absorption laws:
+// a ∨ (a ∧ b) = a and a ∧ (a ∨ b) = a.
+//
+// Example:
+//
+//	func TestMinMaxAbsorption(t *testing.T) {
+//	    join := func(a, b int) int { return max(a, b) }
+//	    meet := func(a, b int) int { return min(a, b) }
+//	    gen := lawtest.IntGen(-100, 100)
+//	    lawtest.Absorption(t, join, meet, gen)
+//	}
+//
+// Common lattices:
+//   - Sets: union and intersection
+//   - Bitmasks: OR and AND
+//   - Ordered values: max and min
+func Absorption[T comparable](t TB, join, meet BinaryOp[T], gen Generator[T]) {
+	AbsorptionWithConfig(t, join, meet, gen, DefaultConfig())
+}
+
+// AbsorptionWithConfig tests the absorption laws with custom configuration.
+func AbsorptionWithConfig[T comparable](t TB, join, meet BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		v := drawDistinct(cfg, gen, 2)
+		a, b := v[0], v[1]
+
+		// a ∨ (a ∧ b) = a
+		aMeetB := meet(a, b)
+		joinResult := join(a, aMeetB)
+		if joinResult != a {
+			return cfg.sprintf("Absorption failed: a∨(a∧b) != a\n  a=%v, b=%v\n  a∧b=%v, a∨(a∧b)=%v",
+				a, b, aMeetB, joinResult)
+		}
+
+		// a ∧ (a ∨ b) = a
+		aJoinB := join(a, b)
+		meetResult := meet(a, aJoinB)
+		if meetResult != a {
+			return cfg.sprintf("Absorption failed: a∧(a∨b) != a\n  a=%v, b=%v\n  a∨b=%v, a∧(a∨b)=%v",
+				a, b, aJoinB, meetResult)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// BOOLEAN ALGEBRA LAWS
+// ===========================================================================
+
+// DeMorgan tests De Morgan's laws:
+// ¬(a ∧ b) = ¬a ∨ ¬b and ¬(a ∨ b) = ¬a ∧ ¬b.
+//
+// Example:
+//
+//	func TestBitsetDeMorgan(t *testing.T) {
+//	    and := func(a, b uint8) uint8 { return a & b }
+//	    or := func(a, b uint8) uint8 { return a | b }
+//	    not := func(a uint8) uint8 { return ^a }
+//	    lawtest.DeMorgan(t, and, or, not, BitsetGen())
+//	}
+func DeMorgan[T comparable](t TB, and, or BinaryOp[T], not UnaryOp[T], gen Generator[T]) {
+	DeMorganWithConfig(t, and, or, not, gen, DefaultConfig())
+}
+
+// DeMorganWithConfig tests De Morgan's laws with custom configuration.
+func DeMorganWithConfig[T comparable](t TB, and, or BinaryOp[T], not UnaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		v := drawDistinct(cfg, gen, 2)
+		a, b := v[0], v[1]
+		notA, notB := not(a), not(b)
+
+		// ¬(a ∧ b) = ¬a ∨ ¬b
+		left := not(and(a, b))
+		right := or(notA, notB)
+		if left != right {
+			return cfg.sprintf("De Morgan failed: ¬(a∧b) != ¬a∨¬b\n  a=%v, b=%v\n  ¬(a∧b)=%v, ¬a∨¬b=%v",
+				a, b, left, right)
+		}
+
+		// ¬(a ∨ b) = ¬a ∧ ¬b
+		left = not(or(a, b))
+		right = and(notA, notB)
+		if left != right {
+			return cfg.sprintf("De Morgan failed: ¬(a∨b) != ¬a∧¬b\n  a=%v, b=%v\n  ¬(a∨b)=%v, ¬a∧¬b=%v",
+				a, b, left, right)
+		}
+
+		return ""
+	})
+}
+
+// Complement tests the complement laws: a ∧ ¬a = ⊥ and a ∨ ¬a = ⊤.
+//
+// top and bottom are the greatest and least elements, such as all-ones and
+// zero for bitmasks or true and false for booleans.
+//
+// Example:
+//
+//	func TestBitsetComplement(t *testing.T) {
+//	    and := func(a, b uint8) uint8 { return a & b }
+//	    or := func(a, b uint8) uint8 { return a | b }
+//	    not := func(a uint8) uint8 { return ^a }
+//	    lawtest.Complement(t, and, or, not, 0xFF, 0x00, BitsetGen())
+//	}
+func Complement[T comparable](t TB, and, or BinaryOp[T], not UnaryOp[T], top, bottom T, gen Generator[T]) {
+	ComplementWithConfig(t, and, or, not, top, bottom, gen, DefaultConfig())
+}
+
+// ComplementWithConfig tests the complement laws with custom configuration.
+func ComplementWithConfig[T comparable](t TB, and, or BinaryOp[T], not UnaryOp[T], top, bottom T, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+		notA := not(a)
+
+		// a ∧ ¬a = ⊥
+		meet := and(a, notA)
+		if meet != bottom {
+			return cfg.sprintf("Complement failed: a∧¬a != ⊥\n  a=%v, ¬a=%v\n  a∧¬a=%v, ⊥=%v",
+				a, notA, meet, bottom)
+		}
+
+		// a ∨ ¬a = ⊤
+		join := or(a, notA)
+		if join != top {
+			return cfg.sprintf("Complement failed: a∨¬a != ⊤\n  a=%v, ¬a=%v\n  a∨¬a=%v, ⊤=%v",
+				a, notA, join, top)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// ANTI-COMMUTATIVITY
+// ===========================================================================
+
+// AntiCommutative tests if swapping operands negates the result:
+// a ∘ b = -(b ∘ a).
+//
+// Subtraction fails Commutative, but it fails in a structured way that
+// AntiCommutative characterizes exactly.
+//
+// Example:
+//
+//	func TestSubtractionAntiCommutative(t *testing.T) {
+//	    sub := func(a, b int) int { return a - b }
+//	    neg := func(a int) int { return -a }
+//	    lawtest.AntiCommutative(t, sub, neg, lawtest.IntGen(-100, 100))
+//	}
+//
+// Common anti-commutative operations:
+//   - Subtraction: a - b = -(b - a)
+//   - Cross product: a × b = -(b × a)
+//   - Commutator: [a, b] = -[b, a]
+func AntiCommutative[T comparable](t TB, op BinaryOp[T], neg UnaryOp[T], gen Generator[T]) {
+	AntiCommutativeWithConfig(t, op, neg, gen, DefaultConfig())
+}
+
+// AntiCommutativeWithConfig tests anti-commutativity with custom configuration.
+func AntiCommutativeWithConfig[T comparable](t TB, op BinaryOp[T], neg UnaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		v := drawDistinct(cfg, gen, 2)
+		a, b := v[0], v[1]
+
+		ab := op(a, b)
+		ba := op(b, a)
+		negBA := neg(ba)
+
+		if ab != negBA {
+			return cfg.sprintf("Anti-commutativity failed: a∘b != -(b∘a)\n  a=%v, b=%v\n  a∘b=%v, b∘a=%v, -(b∘a)=%v",
+				a, b, ab, ba, negBA)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// CANONICAL ORDERING
+// ===========================================================================
+
+// TestCanonicalizes verifies that canonOp, an optimized version of a
+// commutative op that normalizes the order of its operands, agrees with op
+// and ignores operand order: canon(a, b) = canon(b, a) = a ∘ b.
+//
+// Sorting operands before combining them, so that only one order has to be
+// handled or cached, is a common optimization of commutative operations.
+// It silently changes results if op was not commutative after all, or if
+// the normalization itself is wrong.
+//
+// Example:
+//
+//	func TestUnionCanonical(t *testing.T) {
+//	    canonUnion := func(a, b Set) Set {
+//	        if b.Len() < a.Len() {
+//	            a, b = b, a // always merge the smaller set into the larger
+//	        }
+//	        return a.MergeInto(b)
+//	    }
+//	    lawtest.TestCanonicalizes(t, Union, canonUnion, genSet)
+//	}
+func TestCanonicalizes[T comparable](t TB, op BinaryOp[T], canonOp BinaryOp[T], gen Generator[T]) {
+	TestCanonicalizesWithConfig(t, op, canonOp, gen, DefaultConfig())
+}
+
+// TestCanonicalizesWithConfig verifies a canonically ordered operation with custom configuration.
+func TestCanonicalizesWithConfig[T comparable](t TB, op BinaryOp[T], canonOp BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		v := drawDistinct(cfg, gen, 2)
+		a, b := v[0], v[1]
+
+		canonAB := canonOp(a, b)
+		canonBA := canonOp(b, a)
+		if canonAB != canonBA {
+			return cfg.sprintf("Canonicalization failed: canon(a, b) != canon(b, a)\n  a=%v, b=%v\n  canon(a, b)=%v, canon(b, a)=%v",
+				a, b, canonAB, canonBA)
+		}
+
+		if ab := op(a, b); canonAB != ab {
+			return cfg.sprintf("Canonicalization failed: canon(a, b) != a∘b\n  a=%v, b=%v\n  canon(a, b)=%v, a∘b=%v",
+				a, b, canonAB, ab)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// INVARIANTS
+// ===========================================================================
+
+// Invariant tests if a binary operation preserves a domain invariant:
+// inv(a ∘ b) holds for all generated a and b.
+//
+// The generator should only produce values satisfying inv, so that a
+// failure means the operation broke it. Use it alongside the algebraic
+// laws for correctness conditions they can't express.
+//
+// Example:
+//
+//	func TestMergeKeepsCacheValid(t *testing.T) {
+//	    merge := func(a, b *Cache) *Cache { return a.Merge(b) }
+//	    valid := func(c *Cache) bool { return c.Len() <= c.Capacity() }
+//	    lawtest.Invariant(t, merge, genValidCache, valid)
+//	}
+func Invariant[T any](t TB, op BinaryOp[T], gen Generator[T], inv func(T) bool) {
+	InvariantWithConfig(t, op, gen, inv, DefaultConfig())
+}
+
+// InvariantWithConfig tests a binary invariant with custom configuration.
+func InvariantWithConfig[T any](t TB, op BinaryOp[T], gen Generator[T], inv func(T) bool, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+
+		result := op(a, b)
+		if !inv(result) {
+			return cfg.sprintf("Invariant failed: result of a∘b violates the invariant\n  a=%v, b=%v\n  a∘b=%v",
+				a, b, result)
+		}
+
+		return ""
+	})
+}
+
+// InvariantUnary tests if a unary operation preserves a domain invariant:
+// inv(f(a)) holds for all generated a.
+//
+// Example:
+//
+//	func TestNormalizeKeepsPathClean(t *testing.T) {
+//	    clean := func(p string) bool { return !strings.Contains(p, "//") }
+//	    lawtest.InvariantUnary(t, NormalizePath, genPath, clean)
+//	}
+func InvariantUnary[T any](t TB, f UnaryOp[T], gen Generator[T], inv func(T) bool) {
+	InvariantUnaryWithConfig(t, f, gen, inv, DefaultConfig())
+}
+
+// InvariantUnaryWithConfig tests a unary invariant with custom configuration.
+func InvariantUnaryWithConfig[T any](t TB, f UnaryOp[T], gen Generator[T], inv func(T) bool, cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		result := f(a)
+		if !inv(result) {
+			return cfg.sprintf("Invariant failed: result of f(a) violates the invariant\n  a=%v, f(a)=%v",
+				a, result)
+		}
+
+		return ""
+	})
+}
+
+// ValidateGenerator checks that gen itself only produces values satisfying
+// inv, sampling n of them, and reports whether it does.
+//
+// Invariant and the algebraic laws trust their generator: if it produces
+// invalid values, such as a Cache with a nil map, a property can pass or
+// fail for reasons that have nothing to do with the code under test. Run
+// it first and stop if the generator is broken. n defaults to 100 if less
+// than 1.
+//
+// Example:
+//
+//	func TestMergeKeepsCacheValid(t *testing.T) {
+//	    valid := func(c *Cache) bool { return c.entries != nil && c.Len() <= c.Capacity() }
+//	    if !lawtest.ValidateGenerator(t, genValidCache, valid, 500) {
+//	        t.FailNow()
+//	    }
+//	    lawtest.Invariant(t, merge, genValidCache, valid)
+//	}
+func ValidateGenerator[T any](t TB, gen Generator[T], inv func(T) bool, n int) bool {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	if n > 0 {
+		cfg.TestCases = n
+	}
+
+	return checkCases(t, cfg, func(i int) string {
+		v := gen()
+
+		if !inv(v) {
+			return cfg.sprintf("Generator failed: sample %d violates the invariant\n  sample=%v",
+				i, v)
+		}
+
+		return ""
+	})
+}
+
+// ===========================================================================
+// METAMORPHIC RELATIONS
+// ===========================================================================
+
+// Metamorphic tests a relation between the outputs of related inputs:
+// relation(f(x), f(transform(x))) holds for all generated x.
+//
+// It generalizes Equivalent to functions without a reference
+// implementation: the exact output of f is unknown, but how it must change
+// when the input changes is not. relation receives the original output
+// first.
+//
+// Example:
+//
+//	func TestSortIgnoresOrder(t *testing.T) {
+//	    reverse := func(s []int) []int { r := slices.Clone(s); slices.Reverse(r); return r }
+//	    lawtest.Metamorphic(t, reverse, Sort, slices.Equal[[]int], genSlice)
+//	}
+//
+// Other common relations: f(x+k) = f(x)+k for shift-invariant functions,
+// and f(x) ≤ f(x') when x' extends x for monotone aggregates.
+func Metamorphic[T, R any](t TB, transform func(T) T, f func(T) R, relation func(R, R) bool, gen Generator[T]) {
+	MetamorphicWithConfig(t, transform, f, relation, gen, DefaultConfig())
+}
+
+// MetamorphicWithConfig tests a metamorphic relation with custom configuration.
+func MetamorphicWithConfig[T, R any](t TB, transform func(T) T, f func(T) R, relation func(R, R) bool, gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		x := gen()
+
+		// f(x) is computed first, in case f or transform mutates x
+		fx := f(x)
+		tx := transform(x)
+		ftx := f(tx)
+
+		if !relation(fx, ftx) {
+			return cfg.sprintf("Metamorphic relation failed: relation(f(x), f(transform(x))) is false\n  x=%v, transform(x)=%v\n  f(x)=%v, f(transform(x))=%v",
+				x, tx, fx, ftx)
+		}
+
+		return ""
+	})
+}
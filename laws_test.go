@@ -0,0 +1,497 @@
+package lawtest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Testing cancellation laws
+func TestCancellation(t *testing.T) {
+	addOp := func(a, b int) int { return a + b }
+	mulOp := func(a, b int) int { return a * b }
+	intGen := lawtest.IntGen(1, 20)
+
+	t.Run("LeftCancellative", func(t *testing.T) {
+		lawtest.LeftCancellative(t, addOp, intGen)
+		lawtest.LeftCancellative(t, mulOp, intGen)
+	})
+
+	t.Run("RightCancellative", func(t *testing.T) {
+		lawtest.RightCancellative(t, addOp, intGen)
+		lawtest.RightCancellative(t, mulOp, intGen)
+	})
+
+	t.Run("WithConfig", func(t *testing.T) {
+		cfg := lawtest.DefaultConfig()
+		cfg.TestCases = 200
+		lawtest.LeftCancellativeWithConfig(t, addOp, intGen, cfg)
+		lawtest.RightCancellativeWithConfig(t, addOp, intGen, cfg)
+	})
+}
+
+// Testing involutions
+func TestInvolution(t *testing.T) {
+	t.Run("Negation", func(t *testing.T) {
+		negateOp := func(x int) int { return -x }
+		lawtest.Involution(t, negateOp, lawtest.IntGen(-100, 100))
+	})
+
+	t.Run("BooleanNot", func(t *testing.T) {
+		notOp := func(b bool) bool { return !b }
+		lawtest.Involution(t, notOp, lawtest.BoolGen())
+	})
+
+	t.Run("ReverseCustom", func(t *testing.T) {
+		reverse := func(list []int) []int {
+			result := make([]int, len(list))
+			for i := range list {
+				result[len(list)-1-i] = list[i]
+			}
+			return result
+		}
+		gen := func() []int {
+			list := make([]int, rand.Intn(10))
+			for i := range list {
+				list[i] = rand.Intn(100)
+			}
+			return list
+		}
+		lawtest.InvolutionCustom(t, reverse, gen, func(a, b []int) bool {
+			return reflect.DeepEqual(a, b)
+		})
+	})
+}
+
+// Testing monotonic functions
+func TestMonotonic(t *testing.T) {
+	intGen := lawtest.IntGen(-1000, 1000)
+
+	t.Run("NonDecreasing", func(t *testing.T) {
+		bucket := func(x int) int { return x / 10 }
+		lawtest.Monotonic(t, bucket, intGen, true)
+	})
+
+	t.Run("NonIncreasing", func(t *testing.T) {
+		negateOp := func(x int) int { return -x }
+		lawtest.Monotonic(t, negateOp, intGen, false)
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		prefix := func(s string) string { return "key-" + s }
+		lawtest.Monotonic(t, prefix, lawtest.StringGen(4), true)
+	})
+}
+
+// Testing lattice absorption
+func TestAbsorption(t *testing.T) {
+	t.Run("MinMax", func(t *testing.T) {
+		join := func(a, b int) int { return max(a, b) }
+		meet := func(a, b int) int { return min(a, b) }
+		lawtest.Absorption(t, join, meet, lawtest.IntGen(-100, 100))
+	})
+
+	t.Run("Bitmask", func(t *testing.T) {
+		join := func(a, b int) int { return a | b }
+		meet := func(a, b int) int { return a & b }
+		cfg := lawtest.DefaultConfig()
+		cfg.TestCases = 200
+		lawtest.AbsorptionWithConfig(t, join, meet, lawtest.IntGen(0, 255), cfg)
+	})
+}
+
+// Testing binary idempotence of semilattice operations
+func TestBinaryIdempotent(t *testing.T) {
+	gen := lawtest.IntGen(-100, 100)
+	lawtest.BinaryIdempotent(t, func(a, b int) int { return max(a, b) }, gen)
+	lawtest.BinaryIdempotent(t, func(a, b int) int { return a | b }, gen)
+
+	log := &failureLog{}
+	lawtest.BinaryIdempotent(log, func(a, b int) int { return a + b }, lawtest.IntGen(1, 100))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "a∘a != a") {
+		t.Errorf("Expected addition to fail binary idempotence, got %q", log.errors)
+	}
+}
+
+// Testing one-sided identities
+func TestOneSidedIdentity(t *testing.T) {
+	first := func(a, b int) int { return a }
+	second := func(a, b int) int { return b }
+	gen := lawtest.IntGen(-100, 100)
+
+	lawtest.LeftIdentity(t, first, 0, gen)
+	lawtest.RightIdentity(t, second, 0, gen)
+
+	// Each operation is neutral on one side only
+	log := &failureLog{}
+	lawtest.RightIdentity(log, first, 0, gen)
+	lawtest.LeftIdentity(log, second, 0, gen)
+	if len(log.errors) != 2 {
+		t.Errorf("Expected both opposite-side checks to fail, got %q", log.errors)
+	}
+}
+
+// Test that identities are unique among generated values
+func TestIdentityUnique(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	mul := func(a, b int) int { return a * b }
+	concat := func(a, b string) string { return a + b }
+
+	lawtest.TestIdentityUnique(t, add, 0, lawtest.IntGen(-100, 100))
+	lawtest.TestIdentityUnique(t, mul, 1, lawtest.IntGen(-10, 10))
+	lawtest.TestIdentityUnique(t, concat, "", lawtest.StringGen(3))
+
+	// -100 is the bottom of the generated range, so it is max's identity there
+	maxOp := func(a, b int) int { return max(a, b) }
+	lawtest.TestIdentityUniqueWithConfig(t, maxOp, -100, lawtest.IntGen(-100, 100), lawtest.DefaultConfig())
+
+	// Every element is a left identity of the right projection, so the
+	// claimed 0 is only neutral on one side
+	second := func(a, b int) int { return b }
+	log := &failureLog{}
+	lawtest.TestIdentityUnique(log, second, 0, lawtest.IntGen(0, 9))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Identity not unique: e' != e is also neutral (e'∘a = a for every sampled a)") {
+		t.Errorf("Expected a second left identity to be reported, got %q", log.errors)
+	}
+}
+
+// Testing absorbing elements
+func TestAbsorbing(t *testing.T) {
+	mulOp := func(a, b int) int { return a * b }
+	andOp := func(a, b bool) bool { return a && b }
+	minOp := func(a, b int) int { return min(a, b) }
+
+	lawtest.Absorbing(t, mulOp, 0, lawtest.IntGen(-100, 100))
+	lawtest.Absorbing(t, andOp, false, lawtest.BoolGen())
+	lawtest.Absorbing(t, minOp, math.MinInt, lawtest.IntGen(-100, 100))
+
+	// The identity is not absorbing
+	log := &failureLog{}
+	lawtest.Absorbing(log, mulOp, 1, lawtest.IntGen(2, 100))
+	if len(log.errors) != 1 {
+		t.Errorf("Expected 1 to fail as an absorbing element, got %q", log.errors)
+	}
+}
+
+// Testing one-sided absorbing elements
+func TestOneSidedAbsorbing(t *testing.T) {
+	first := func(a, b int) int { return a }
+	second := func(a, b int) int { return b }
+	gen := lawtest.IntGen(-100, 100)
+
+	lawtest.LeftAbsorbing(t, first, 7, gen)
+	lawtest.RightAbsorbing(t, second, 7, gen)
+
+	// Each projection absorbs from one side only, which Absorbing rejects
+	log := &failureLog{}
+	lawtest.RightAbsorbing(log, first, 7, gen)
+	lawtest.LeftAbsorbing(log, second, 7, gen)
+	lawtest.Absorbing(log, first, 7, gen)
+	if len(log.errors) != 3 || !strings.Contains(log.errors[0], "Right zero failed: a∘0 != 0") ||
+		!strings.Contains(log.errors[1], "Left zero failed: 0∘a != 0") ||
+		!strings.Contains(log.errors[2], "Absorption failed: a∘0 != 0") {
+		t.Errorf("Expected the opposite sides and Absorbing to fail, got %q", log.errors)
+	}
+}
+
+// Testing De Morgan's laws
+func TestDeMorgan(t *testing.T) {
+	andOp := func(a, b uint8) uint8 { return a & b }
+	orOp := func(a, b uint8) uint8 { return a | b }
+	notOp := func(a uint8) uint8 { return ^a }
+	gen := func() uint8 { return uint8(rand.Intn(256)) }
+
+	lawtest.DeMorgan(t, andOp, orOp, notOp, gen)
+	lawtest.DeMorgan(t,
+		func(a, b bool) bool { return a && b },
+		func(a, b bool) bool { return a || b },
+		func(a bool) bool { return !a },
+		lawtest.BoolGen())
+
+	// Clearing only the low bit is not a complement
+	log := &failureLog{}
+	lawtest.DeMorgan(log, andOp, orOp, func(a uint8) uint8 { return a &^ 1 }, gen)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "De Morgan failed") {
+		t.Errorf("Expected a De Morgan failure, got %q", log.errors)
+	}
+}
+
+// Testing complement laws
+func TestComplement(t *testing.T) {
+	andOp := func(a, b uint8) uint8 { return a & b }
+	orOp := func(a, b uint8) uint8 { return a | b }
+	notOp := func(a uint8) uint8 { return ^a }
+	gen := func() uint8 { return uint8(rand.Intn(256)) }
+
+	lawtest.Complement(t, andOp, orOp, notOp, 0xFF, 0x00, gen)
+
+	// Swapping top and bottom breaks both laws; the first is reported
+	log := &failureLog{}
+	lawtest.Complement(log, andOp, orOp, notOp, 0x00, 0xFF, gen)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "a∧¬a != ⊥") {
+		t.Errorf("Expected the meet law to fail, got %q", log.errors)
+	}
+}
+
+// Testing distributivity
+func TestDistributive(t *testing.T) {
+	addOp := func(a, b int) int { return a + b }
+	mulOp := func(a, b int) int { return a * b }
+	gen := lawtest.IntGen(-100, 100)
+
+	lawtest.Distributive(t, mulOp, addOp, gen)
+	lawtest.Distributive(t, func(a, b int) int { return min(a, b) }, func(a, b int) int { return max(a, b) }, gen)
+
+	// Addition does not distribute over multiplication
+	log := &failureLog{}
+	lawtest.Distributive(log, addOp, mulOp, gen)
+	if len(log.errors) != 1 {
+		t.Errorf("Expected addition over multiplication to fail, got %q", log.errors)
+	}
+}
+
+// Testing anti-commutativity
+func TestAntiCommutative(t *testing.T) {
+	subOp := func(a, b int) int { return a - b }
+	negOp := func(a int) int { return -a }
+	gen := lawtest.IntGen(-100, 100)
+
+	lawtest.AntiCommutative(t, subOp, negOp, gen)
+
+	type Vec struct{ X, Y, Z int }
+	cross := func(a, b Vec) Vec {
+		return Vec{a.Y*b.Z - a.Z*b.Y, a.Z*b.X - a.X*b.Z, a.X*b.Y - a.Y*b.X}
+	}
+	negVec := func(v Vec) Vec { return Vec{-v.X, -v.Y, -v.Z} }
+	vecGen := func() Vec { return Vec{gen(), gen(), gen()} }
+	lawtest.AntiCommutative(t, cross, negVec, vecGen)
+
+	// Addition commutes, so it is not anti-commutative
+	log := &failureLog{}
+	lawtest.AntiCommutative(log, func(a, b int) int { return a + b }, negOp, lawtest.IntGen(1, 100))
+	if len(log.errors) != 1 {
+		t.Errorf("Expected addition to fail anti-commutativity, got %q", log.errors)
+	}
+}
+
+// Test that an operand-sorting optimization agrees with the original operation
+func TestCanonicalizes(t *testing.T) {
+	gcd := func(a, b int) int {
+		for b != 0 {
+			a, b = b, a%b
+		}
+		return a
+	}
+	// Puts the larger operand first so the loop starts with a >= b
+	canonGCD := func(a, b int) int {
+		if a < b {
+			a, b = b, a
+		}
+		return gcd(a, b)
+	}
+	lawtest.TestCanonicalizes(t, gcd, canonGCD, lawtest.IntGen(0, 1000))
+
+	cfg := lawtest.DefaultConfig()
+	cfg.RequireDistinct = true
+	maxOp := func(a, b int) int { return max(a, b) }
+	// Orders the operands and returns the second
+	canonMax := func(a, b int) int {
+		if a > b {
+			a, b = b, a
+		}
+		return b
+	}
+	lawtest.TestCanonicalizesWithConfig(t, maxOp, canonMax, lawtest.IntGen(-100, 100), cfg)
+
+	// Ordering the operands of a non-commutative op changes its result
+	sub := func(a, b int) int { return a - b }
+	canonSub := func(a, b int) int { return sub(min(a, b), max(a, b)) }
+	log := &failureLog{}
+	lawtest.TestCanonicalizesWithConfig(log, sub, canonSub, lawtest.IntGen(-100, 100), cfg)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "canon(a, b) != a∘b") {
+		t.Errorf("Expected the changed result to be reported, got %q", log.errors)
+	}
+
+	// A normalization that doesn't normalize
+	log = &failureLog{}
+	lawtest.TestCanonicalizesWithConfig(log, sub, sub, lawtest.IntGen(-100, 100), cfg)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "canon(a, b) != canon(b, a)") {
+		t.Errorf("Expected the operand order to matter, got %q", log.errors)
+	}
+}
+
+// Testing invariants preserved by operations
+func TestInvariant(t *testing.T) {
+	nonNegative := func(n int) bool { return n >= 0 }
+	gen := lawtest.IntGen(0, 100)
+
+	lawtest.Invariant(t, func(a, b int) int { return a + b }, gen, nonNegative)
+	lawtest.InvariantUnary(t, func(a int) int { return a * a }, gen, nonNegative)
+
+	log := &failureLog{}
+	lawtest.Invariant(log, func(a, b int) int { return a - b }, gen, nonNegative)
+	lawtest.InvariantUnary(log, func(a int) int { return -a - 1 }, gen, nonNegative)
+	if len(log.errors) != 2 || !strings.Contains(log.errors[1], "f(a)=") {
+		t.Errorf("Expected both invariants to fail, got %q", log.errors)
+	}
+}
+
+// Test that generators are validated against their own invariant
+func TestValidateGenerator(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	evens := lawtest.Map(lawtest.IntGen(-50, 50), func(n int) int { return 2 * n })
+
+	if !lawtest.ValidateGenerator(t, evens, even, 200) {
+		t.Error("Expected doubled integers to be even")
+	}
+
+	log := &failureLog{}
+	if lawtest.ValidateGenerator(log, lawtest.IntGen(-50, 50), even, 0) {
+		t.Error("Expected arbitrary integers to fail validation")
+	}
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "violates the invariant\n  sample=") {
+		t.Errorf("Expected the first odd sample to be reported, got %q", log.errors)
+	}
+}
+
+// Testing metamorphic relations
+func TestMetamorphic(t *testing.T) {
+	sorted := func(s []int) []int {
+		r := slices.Clone(s)
+		slices.Sort(r)
+		return r
+	}
+	reverse := func(s []int) []int {
+		r := slices.Clone(s)
+		slices.Reverse(r)
+		return r
+	}
+	gen := lawtest.SliceGen(lawtest.IntGen(-50, 50), 0, 10)
+	lawtest.Metamorphic(t, reverse, sorted, slices.Equal[[]int], gen)
+
+	// Shifting every element shifts the maximum by the same amount
+	shift := func(s []int) []int {
+		r := slices.Clone(s)
+		for i := range r {
+			r[i] += 7
+		}
+		return r
+	}
+	shifted := func(a, b int) bool { return b == a+7 }
+	lawtest.Metamorphic(t, shift, slices.Max[[]int], shifted, lawtest.SliceGen(lawtest.IntGen(-50, 50), 1, 10))
+
+	log := &failureLog{}
+	first := func(s []int) int { return s[0] }
+	lawtest.Metamorphic(log, reverse, first, func(a, b int) bool { return a == b }, lawtest.SliceGen(lawtest.IntGen(0, 9), 2, 5))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "transform(x)=") {
+		t.Errorf("Expected the first element to change under reversal, got %q", log.errors)
+	}
+}
+
+// Testing fixpoint convergence
+func TestFixpointConverges(t *testing.T) {
+	// Halving toward zero takes several applications, which Idempotent rejects
+	halve := func(x int) int { return x / 2 }
+	log := &logRecorder{}
+	lawtest.FixpointConverges(log, halve, lawtest.IntGen(-1000, 1000), 20)
+	if len(log.errors) != 0 {
+		t.Fatalf("Expected halving to converge, got %q", log.errors)
+	}
+	if len(log.logs) != 1 || !strings.Contains(log.logs[0], "within") {
+		t.Errorf("Expected the convergence count to be logged, got %q", log.logs)
+	}
+
+	// Escaping quotes never stabilizes (the http_server.go bug)
+	escape := func(s string) string { return strings.ReplaceAll(s, `"`, `\"`) }
+	failed := &failureLog{}
+	lawtest.FixpointConverges(failed, escape, lawtest.Map(lawtest.StringGen(5), strconv.Quote), 3)
+	if len(failed.errors) != 1 || !strings.Contains(failed.errors[0], "no fixpoint within 3 applications") {
+		t.Errorf("Expected escaping to diverge, got %q", failed.errors)
+	}
+
+	// Negation oscillates between x and -x
+	failed = &failureLog{}
+	negate := func(x int) int { return -x }
+	lawtest.FixpointConverges(failed, negate, lawtest.IntGen(1, 100), 10)
+	if len(failed.errors) != 1 || !strings.Contains(failed.errors[0], "oscillates with period 2") {
+		t.Errorf("Expected negation to oscillate, got %q", failed.errors)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "maxIters (-1) must be >= 0") {
+				t.Errorf("Expected panic for maxIters < 0, got %v", r)
+			}
+		}()
+		lawtest.FixpointConverges(t, negate, lawtest.IntGen(1, 100), -1)
+	}()
+}
+
+// Testing round trips
+func TestRoundTrip(t *testing.T) {
+	parse := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+	lawtest.RoundTrip(t, strconv.Itoa, parse, lawtest.IntGen(-1e9, 1e9))
+
+	encode := func(m map[string]int) []byte {
+		b, _ := json.Marshal(m)
+		return b
+	}
+	decode := func(b []byte) map[string]int {
+		var m map[string]int
+		json.Unmarshal(b, &m)
+		return m
+	}
+	gen := lawtest.MapGen(lawtest.StringGen(5), lawtest.IntGen(-100, 100), 1, 10)
+	lawtest.RoundTripCustom(t, encode, decode, gen, maps.Equal[map[string]int, map[string]int])
+
+	// Formatting with %.2f loses precision
+	log := &failureLog{}
+	format := func(f float64) string { return strconv.FormatFloat(f, 'f', 2, 64) }
+	parseFloat := func(s string) float64 {
+		f, _ := strconv.ParseFloat(s, 64)
+		return f
+	}
+	lawtest.RoundTrip(log, format, parseFloat, lawtest.Float64Gen(-10, 10))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Round trip failed: decode(encode(x)) != x") {
+		t.Errorf("Expected lossy formatting to fail, got %q", log.errors)
+	}
+}
+
+// Testing bijections in both directions
+func TestBijective(t *testing.T) {
+	shift := func(r rune) rune { return 'a' + (r-'a'+3)%26 }
+	unshift := func(r rune) rune { return 'a' + (r-'a'+23)%26 }
+	letters := lawtest.Map(lawtest.IntGen(0, 25), func(n int) rune { return 'a' + rune(n) })
+	lawtest.Bijective(t, shift, unshift, letters, letters)
+
+	toBytes := func(s string) []byte { return []byte(s) }
+	toString := func(b []byte) string { return string(b) }
+	strEq := func(a, b string) bool { return a == b }
+	genBytes := lawtest.Map(lawtest.StringGen(10), toBytes)
+	lawtest.BijectiveCustom(t, toBytes, toString, lawtest.StringGen(10), genBytes, strEq, slices.Equal[[]byte])
+
+	// Itoa/Atoi round-trips, but zero-padded strings like "007" parse to
+	// numbers that format differently
+	log := &failureLog{}
+	parse := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+	padded := lawtest.Map(lawtest.IntGen(0, 99), func(n int) string { return fmt.Sprintf("%03d", n) })
+	lawtest.Bijective(log, strconv.Itoa, parse, lawtest.IntGen(-100, 100), padded)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Bijection failed: f(g(y)) != y") {
+		t.Errorf("Expected the reverse direction to fail, got %q", log.errors)
+	}
+}
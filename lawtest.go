@@ -41,6 +41,16 @@
 //   - Closure: result type matches input types
 //   - Idempotence: f(f(x)) = f(x)
 //
+// # One-Sided Laws
+//
+// LeftIdentity, RightIdentity, LeftAbsorbing and RightAbsorbing each check
+// a single equation, and that equation is what their failure messages
+// print. The identity variants split Identity as it reports itself:
+// LeftIdentity checks a ∘ e = a and RightIdentity checks e ∘ a = a. The
+// absorbing variants follow the algebraic names for zeros: LeftAbsorbing
+// checks a left zero, 0 ∘ a = 0, and RightAbsorbing a right zero,
+// a ∘ 0 = 0. Absorbing checks both equations and names no side.
+//
 // # Interface-Based Testing
 //
 // For complex types, implement Group, Monoid, or Semigroup interfaces
@@ -87,9 +97,12 @@
 package lawtest
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 )
@@ -97,6 +110,11 @@ import (
 // defaultTestCases is the default number of random test cases to generate.
 const defaultTestCases = 100
 
+// searchCandidates is how many candidate values are drawn per case when a
+// property has to search the generator for a related value, such as a
+// collision that would violate cancellation.
+const searchCandidates = 20
+
 // BinaryOp is a binary operation that combines two values of type T.
 //
 // Example:
@@ -134,6 +152,18 @@ type UnaryOp[T any] func(a T) T
 //	}
 type Generator[T any] func() T
 
+// TB is the subset of testing.TB the property functions report through.
+//
+// *testing.T and *testing.B both satisfy it, so properties can be checked
+// from benchmarks as well as tests, and so can custom recorders that
+// collect failures instead of failing a test. Functions that group several
+// properties into subtests need t.Run and keep taking a *testing.T.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Logf(format string, args ...any)
+}
+
 // Config holds configuration for property testing.
 //
 // Use DefaultConfig() for sensible defaults, or customize for specific needs:
@@ -141,10 +171,98 @@ type Generator[T any] func() T
 //	cfg := &lawtest.Config{
 //	    TestCases: 500,           // Run 500 random tests
 //	    Timeout:   10 * time.Second, // Max 10 seconds per test
+//	    Seed:      42,            // Replay the exact same inputs
 //	}
 type Config struct {
-	TestCases int           // Number of random test cases to generate and verify
-	Timeout   time.Duration // Maximum time allowed per property test
+	TestCases         int              // Number of random test cases to generate and verify
+	Timeout           time.Duration    // Maximum time allowed per property test (0 disables the limit)
+	Seed              int64            // Seed for random generation (0 picks a time-based seed per run)
+	LogSeed           bool             // Log the seed at the start of every property run
+	Shrinker          any              // Shrinker[T] used to minimize counterexamples (nil disables shrinking)
+	ShrinkFunc        func(any) []any  // Shrinks counterexamples of any type, for when no Shrinker[T] applies (nil disables it)
+	MaxShrink         int              // Maximum number of shrink candidates tried per failure (0 means 1000)
+	ReportAll         bool             // Keep running after a failure and report every distinct failure (up to 10)
+	RequireDistinct   bool             // Redraw the operands of pair/triple properties until they differ (best effort)
+	SelfOpProbability float64          // Chance that a pair/triple operand repeats the previous one, e.g. b=a (0 disables it; excludes RequireDistinct)
+	Verbose           bool             // Log a summary of the generated inputs after every run (see Stats)
+	Classify          any              // Classifier[T] labeling inputs for the distribution report; Check-based properties only (nil disables it)
+	FuzzCorpusDir     string           // Directory such as testdata/fuzz/FuzzMerge to save counterexamples to as fuzz seeds; Check-based properties only ("" disables it)
+	Formatter         func(any) string // Formats values in failure messages, e.g. CompactFormatter (nil uses %v)
+
+	ctx     context.Context // Stops the run when done; set by the WithContext functions
+	sources *sourceTally    // Tallies of the generators labeled with this Config; set by Generator.Label
+}
+
+// Rand returns a new random source seeded with c.Seed, or with the
+// -lawtest.seed flag if it was given.
+//
+// Pass it to the seeded generator constructors so that a run can be
+// reproduced exactly by reusing the same seed:
+//
+//	cfg := lawtest.DefaultConfig()
+//	cfg.Seed = 1234
+//	gen := lawtest.IntGenSeeded(-100, 100, cfg.Rand())
+//	lawtest.AssociativeWithConfig(t, add, gen, cfg)
+//
+// If c.Seed is 0, Rand picks a time-based seed and stores it in c.Seed, so
+// that runs using c report the seed the source was built from.
+func (c *Config) Rand() *rand.Rand {
+	if c.Seed == 0 {
+		c.Seed = resolveSeed(c)
+	}
+	return NewRand(resolveSeed(c))
+}
+
+// drawDistinct draws n operands from gen.
+//
+// With cfg.RequireDistinct the draw is repeated, up to searchCandidates
+// times, until the operands are pairwise distinct. Generators whose range
+// is too small to ever produce n distinct values fall back to the last
+// draw rather than failing or hanging.
+//
+// With cfg.SelfOpProbability each operand after the first is replaced by
+// the one before it with that probability, so that self-merges such as
+// a∘a are checked even when gen rarely repeats a value. The two options
+// pull in opposite directions, and setting both panics.
+func drawDistinct[T comparable](cfg *Config, gen Generator[T], n int) []T {
+	p := cfg.SelfOpProbability
+	if !(p >= 0 && p <= 1) {
+		panic(fmt.Sprintf("SelfOpProbability (%v) must be in [0, 1]", p))
+	}
+	if p > 0 && cfg.RequireDistinct {
+		panic("RequireDistinct and SelfOpProbability are mutually exclusive")
+	}
+
+	v := make([]T, n)
+	for attempt := 0; ; attempt++ {
+		for i := range v {
+			v[i] = gen()
+		}
+		if !cfg.RequireDistinct || attempt+1 >= searchCandidates || pairwiseDistinct(v) {
+			break
+		}
+	}
+
+	if p > 0 {
+		for i := 1; i < n; i++ {
+			if defaultRand.Float64() < p {
+				v[i] = v[i-1]
+			}
+		}
+	}
+	return v
+}
+
+// pairwiseDistinct reports whether no two values in v are equal.
+func pairwiseDistinct[T comparable](v []T) bool {
+	for i := range v {
+		for j := i + 1; j < len(v); j++ {
+			if v[i] == v[j] {
+				return false
+			}
+		}
+	}
+	return true
 }
 
 // DefaultConfig returns a Config with sensible defaults.
@@ -164,6 +282,27 @@ func DefaultConfig() *Config {
 	}
 }
 
+// WithSeed returns the default configuration with every run driven by
+// seed, and the seed logged at the start of each run.
+//
+// Seeded generators drawing from cfg.Rand() replay the exact same inputs for
+// the same seed, and so do the built-in generators in tests that don't run
+// in parallel with others (see NewRand). The -lawtest.seed flag
+// overrides the seed from the command line without editing the test:
+//
+//	go test -run TestMerge -lawtest.seed=1234
+//
+// Example:
+//
+//	cfg := lawtest.WithSeed(1234)
+//	lawtest.AssociativeWithConfig(t, merge, gen, cfg)
+func WithSeed(seed int64) *Config {
+	cfg := DefaultConfig()
+	cfg.Seed = seed
+	cfg.LogSeed = true
+	return cfg
+}
+
 // Associative tests if a binary operation is associative: (a ∘ b) ∘ c = a ∘ (b ∘ c).
 //
 // Associativity means the order of applying operations doesn't matter,
@@ -178,7 +317,7 @@ func DefaultConfig() *Config {
 //	}
 //
 // This verifies: (a + b) + c = a + (b + c) for 100 random combinations.
-func Associative[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T]) {
+func Associative[T comparable](t TB, op BinaryOp[T], gen Generator[T]) {
 	AssociativeWithConfig(t, op, gen, DefaultConfig())
 }
 
@@ -190,24 +329,36 @@ func Associative[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T]) {
 //
 //	cfg := &lawtest.Config{TestCases: 500}
 //	lawtest.AssociativeWithConfig(t, op, gen, cfg)
-func AssociativeWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T], cfg *Config) {
+func AssociativeWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
-		a, b, c := gen(), gen(), gen()
+	CheckAssociative(op, gen, pinSeed(t, cfg)).report(t)
+}
 
-		// (a ∘ b) ∘ c
-		left := op(op(a, b), c)
+// CheckAssociative checks associativity without a *testing.T and returns the outcome.
+func CheckAssociative[T comparable](op BinaryOp[T], gen Generator[T], cfg *Config) Result[T] {
+	var ex counterexample[T]
 
-		// a ∘ (b ∘ c)
-		right := op(a, op(b, c))
+	run := runSeeded(cfg, func(int) string {
+		return ex.failing(cfg, drawDistinct(cfg, gen, 3), func(v []T) string {
+			a, b, c := v[0], v[1], v[2]
 
-		if left != right {
-			t.Errorf("Associativity failed: (a∘b)∘c != a∘(b∘c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
-				a, b, c, left, right)
-			return
-		}
-	}
+			// (a ∘ b) ∘ c
+			left := op(op(a, b), c)
+
+			// a ∘ (b ∘ c)
+			right := op(a, op(b, c))
+
+			if left != right {
+				return cfg.sprintf("Associativity failed: (a∘b)∘c != a∘(b∘c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
+					a, b, c, left, right) + cfg.diff(left, right)
+			}
+
+			return ""
+		})
+	})
+
+	return newResult(cfg, run, &ex)
 }
 
 // Commutative tests if a binary operation is commutative: a ∘ b = b ∘ a.
@@ -224,26 +375,38 @@ func AssociativeWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Gener
 //	}
 //
 // This verifies: a * b = b * a for 100 random pairs.
-func Commutative[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T]) {
+func Commutative[T comparable](t TB, op BinaryOp[T], gen Generator[T]) {
 	CommutativeWithConfig(t, op, gen, DefaultConfig())
 }
 
 // CommutativeWithConfig tests commutativity with custom configuration.
-func CommutativeWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T], cfg *Config) {
+func CommutativeWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
-		a, b := gen(), gen()
+	CheckCommutative(op, gen, pinSeed(t, cfg)).report(t)
+}
 
-		left := op(a, b)
-		right := op(b, a)
+// CheckCommutative checks commutativity without a *testing.T and returns the outcome.
+func CheckCommutative[T comparable](op BinaryOp[T], gen Generator[T], cfg *Config) Result[T] {
+	var ex counterexample[T]
 
-		if left != right {
-			t.Errorf("Commutativity failed: a∘b != b∘a\n  a=%v, b=%v\n  a∘b=%v, b∘a=%v",
-				a, b, left, right)
-			return
-		}
-	}
+	run := runSeeded(cfg, func(int) string {
+		return ex.failing(cfg, drawDistinct(cfg, gen, 2), func(v []T) string {
+			a, b := v[0], v[1]
+
+			left := op(a, b)
+			right := op(b, a)
+
+			if left != right {
+				return cfg.sprintf("Commutativity failed: a∘b != b∘a\n  a=%v, b=%v\n  a∘b=%v, b∘a=%v",
+					a, b, left, right) + cfg.diff(left, right)
+			}
+
+			return ""
+		})
+	})
+
+	return newResult(cfg, run, &ex)
 }
 
 // Identity tests if an identity element exists: a ∘ e = a and e ∘ a = a.
@@ -264,33 +427,44 @@ func CommutativeWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Gener
 //   - Multiplication: 1 (a * 1 = a)
 //   - String concatenation: "" (s + "" = s)
 //   - Boolean OR: false (b || false = b)
-func Identity[T comparable](t *testing.T, op BinaryOp[T], identity T, gen Generator[T]) {
+func Identity[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T]) {
 	IdentityWithConfig(t, op, identity, gen, DefaultConfig())
 }
 
 // IdentityWithConfig tests identity element with custom configuration.
-func IdentityWithConfig[T comparable](t *testing.T, op BinaryOp[T], identity T, gen Generator[T], cfg *Config) {
+func IdentityWithConfig[T comparable](t TB, op BinaryOp[T], identity T, gen Generator[T], cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
-		a := gen()
+	CheckIdentity(op, identity, gen, pinSeed(t, cfg)).report(t)
+}
 
-		// a ∘ e = a
-		leftResult := op(a, identity)
-		if leftResult != a {
-			t.Errorf("Left identity failed: a∘e != a\n  a=%v, e=%v, a∘e=%v",
-				a, identity, leftResult)
-			return
-		}
+// CheckIdentity checks an identity element without a *testing.T and returns the outcome.
+func CheckIdentity[T comparable](op BinaryOp[T], identity T, gen Generator[T], cfg *Config) Result[T] {
+	var ex counterexample[T]
 
-		// e ∘ a = a
-		rightResult := op(identity, a)
-		if rightResult != a {
-			t.Errorf("Right identity failed: e∘a != a\n  e=%v, a=%v, e∘a=%v",
-				identity, a, rightResult)
-			return
-		}
-	}
+	run := runSeeded(cfg, func(int) string {
+		return ex.failing(cfg, []T{gen()}, func(v []T) string {
+			a := v[0]
+
+			// a ∘ e = a
+			leftResult := op(a, identity)
+			if leftResult != a {
+				return cfg.sprintf("Left identity failed: a∘e != a\n  a=%v, e=%v, a∘e=%v",
+					a, identity, leftResult)
+			}
+
+			// e ∘ a = a
+			rightResult := op(identity, a)
+			if rightResult != a {
+				return cfg.sprintf("Right identity failed: e∘a != a\n  e=%v, a=%v, e∘a=%v",
+					identity, a, rightResult)
+			}
+
+			return ""
+		})
+	})
+
+	return newResult(cfg, run, &ex)
 }
 
 // Inverse tests if each element has an inverse: a ∘ a⁻¹ = e and a⁻¹ ∘ a = e.
@@ -312,34 +486,45 @@ func IdentityWithConfig[T comparable](t *testing.T, op BinaryOp[T], identity T,
 //   - Addition: negation (a + (-a) = 0)
 //   - Multiplication: reciprocal (a * (1/a) = 1)
 //   - Boolean XOR: self (a ⊕ a = false)
-func Inverse[T comparable](t *testing.T, op BinaryOp[T], inverse UnaryOp[T], identity T, gen Generator[T]) {
+func Inverse[T comparable](t TB, op BinaryOp[T], inverse UnaryOp[T], identity T, gen Generator[T]) {
 	InverseWithConfig(t, op, inverse, identity, gen, DefaultConfig())
 }
 
 // InverseWithConfig tests inverse elements with custom configuration.
-func InverseWithConfig[T comparable](t *testing.T, op BinaryOp[T], inv UnaryOp[T], identity T, gen Generator[T], cfg *Config) {
+func InverseWithConfig[T comparable](t TB, op BinaryOp[T], inv UnaryOp[T], identity T, gen Generator[T], cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
-		a := gen()
-		aInv := inv(a)
+	CheckInverse(op, inv, identity, gen, pinSeed(t, cfg)).report(t)
+}
 
-		// a ∘ a⁻¹ = e
-		leftResult := op(a, aInv)
-		if leftResult != identity {
-			t.Errorf("Left inverse failed: a∘a⁻¹ != e\n  a=%v, a⁻¹=%v, e=%v, a∘a⁻¹=%v",
-				a, aInv, identity, leftResult)
-			return
-		}
+// CheckInverse checks inverse elements without a *testing.T and returns the outcome.
+func CheckInverse[T comparable](op BinaryOp[T], inv UnaryOp[T], identity T, gen Generator[T], cfg *Config) Result[T] {
+	var ex counterexample[T]
 
-		// a⁻¹ ∘ a = e
-		rightResult := op(aInv, a)
-		if rightResult != identity {
-			t.Errorf("Right inverse failed: a⁻¹∘a != e\n  a⁻¹=%v, a=%v, e=%v, a⁻¹∘a=%v",
-				aInv, a, identity, rightResult)
-			return
-		}
-	}
+	run := runSeeded(cfg, func(int) string {
+		return ex.failing(cfg, []T{gen()}, func(v []T) string {
+			a := v[0]
+			aInv := inv(a)
+
+			// a ∘ a⁻¹ = e
+			leftResult := op(a, aInv)
+			if leftResult != identity {
+				return cfg.sprintf("Left inverse failed: a∘a⁻¹ != e\n  a=%v, a⁻¹=%v, e=%v, a∘a⁻¹=%v",
+					a, aInv, identity, leftResult)
+			}
+
+			// a⁻¹ ∘ a = e
+			rightResult := op(aInv, a)
+			if rightResult != identity {
+				return cfg.sprintf("Right inverse failed: a⁻¹∘a != e\n  a⁻¹=%v, a=%v, e=%v, a⁻¹∘a=%v",
+					aInv, a, identity, rightResult)
+			}
+
+			return ""
+		})
+	})
+
+	return newResult(cfg, run, &ex)
 }
 
 // Closure tests if an operation stays within the same type.
@@ -357,28 +542,46 @@ func InverseWithConfig[T comparable](t *testing.T, op BinaryOp[T], inv UnaryOp[T
 //	}
 //
 // The test verifies that Set.Union(Set) always returns a Set.
-func Closure[T any](t *testing.T, op BinaryOp[T], gen Generator[T]) {
-	t.Helper()
-
-	// Generate a few examples to show closure is maintained
-	for i := 0; i < 10; i++ {
-		a, b := gen(), gen()
-		result := op(a, b)
+func Closure[T any](t TB, op BinaryOp[T], gen Generator[T]) {
+	ClosureWithConfig(t, op, gen, DefaultConfig())
+}
 
-		// Type is enforced by Go's generics, but we can check the operation completes
-		aType := reflect.TypeOf(a)
-		resultType := reflect.TypeOf(result)
+// ClosureWithConfig tests closure with custom configuration.
+func ClosureWithConfig[T any](t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
+	t.Helper()
 
-		if aType != resultType {
-			t.Errorf("Closure violated: operation changed type\n  input type=%v, result type=%v",
-				aType, resultType)
-			return
-		}
+	if !CheckClosure(op, gen, pinSeed(t, cfg)).report(t) {
+		return
 	}
 
 	t.Logf("✓ Closure property holds (enforced by Go's type system)")
 }
 
+// CheckClosure checks closure without a *testing.T and returns the outcome.
+func CheckClosure[T any](op BinaryOp[T], gen Generator[T], cfg *Config) Result[T] {
+	var ex counterexample[T]
+
+	run := runSeeded(cfg, func(int) string {
+		return ex.failing(cfg, []T{gen(), gen()}, func(v []T) string {
+			a, b := v[0], v[1]
+			result := op(a, b)
+
+			// Type is enforced by Go's generics, but we can check the operation completes
+			aType := reflect.TypeOf(a)
+			resultType := reflect.TypeOf(result)
+
+			if aType != resultType {
+				return cfg.sprintf("Closure violated: operation changed type\n  input type=%v, result type=%v",
+					aType, resultType)
+			}
+
+			return ""
+		})
+	})
+
+	return newResult(cfg, run, &ex)
+}
+
 // Idempotent tests if repeated application gives the same result: f(f(x)) = f(x).
 //
 // An idempotent operation can be applied multiple times without changing
@@ -405,26 +608,38 @@ func Closure[T any](t *testing.T, op BinaryOp[T], gen Generator[T]) {
 //   - Absolute value: abs(abs(x)) = abs(x)
 //   - Set deduplication: dedupe(dedupe(set)) = dedupe(set)
 //   - Cache warming: warm(warm(cache)) = warm(cache)
-func Idempotent[T comparable](t *testing.T, op UnaryOp[T], gen Generator[T]) {
+func Idempotent[T comparable](t TB, op UnaryOp[T], gen Generator[T]) {
 	IdempotentWithConfig(t, op, gen, DefaultConfig())
 }
 
 // IdempotentWithConfig tests idempotence with custom configuration.
-func IdempotentWithConfig[T comparable](t *testing.T, op UnaryOp[T], gen Generator[T], cfg *Config) {
+func IdempotentWithConfig[T comparable](t TB, op UnaryOp[T], gen Generator[T], cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
-		x := gen()
+	CheckIdempotent(op, gen, pinSeed(t, cfg)).report(t)
+}
+
+// CheckIdempotent checks idempotence without a *testing.T and returns the outcome.
+func CheckIdempotent[T comparable](op UnaryOp[T], gen Generator[T], cfg *Config) Result[T] {
+	var ex counterexample[T]
 
-		fx := op(x)
-		ffx := op(fx)
+	run := runSeeded(cfg, func(int) string {
+		return ex.failing(cfg, []T{gen()}, func(v []T) string {
+			x := v[0]
 
-		if fx != ffx {
-			t.Errorf("Idempotence failed: f(f(x)) != f(x)\n  x=%v, f(x)=%v, f(f(x))=%v",
-				x, fx, ffx)
-			return
-		}
-	}
+			fx := op(x)
+			ffx := op(fx)
+
+			if fx != ffx {
+				return cfg.sprintf("Idempotence failed: f(f(x)) != f(x)\n  x=%v, f(x)=%v, f(f(x))=%v",
+					x, fx, ffx)
+			}
+
+			return ""
+		})
+	})
+
+	return newResult(cfg, run, &ex)
 }
 
 // IntGen creates a Generator that produces random integers in [min, max].
@@ -441,11 +656,15 @@ func IdempotentWithConfig[T comparable](t *testing.T, op UnaryOp[T], gen Generat
 //
 // Panics if min > max.
 func IntGen(min, max int) Generator[int] {
+	return intGen(min, max, defaultRand.Intn)
+}
+
+func intGen(min, max int, intn func(int) int) Generator[int] {
 	if min > max {
 		panic(fmt.Sprintf("min (%d) must be <= max (%d)", min, max))
 	}
 	return func() int {
-		return min + rand.Intn(max-min+1)
+		return min + intn(max-min+1)
 	}
 }
 
@@ -470,11 +689,15 @@ func IntGen(min, max int) Generator[int] {
 //	    return string(b)
 //	}
 func StringGen(n int) Generator[string] {
+	return stringGen(n, defaultRand.Intn)
+}
+
+func stringGen(n int, intn func(int) int) Generator[string] {
 	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 	return func() string {
 		b := make([]byte, n)
 		for i := range b {
-			b[i] = charset[rand.Intn(len(charset))]
+			b[i] = charset[intn(len(charset))]
 		}
 		return string(b)
 	}
@@ -489,11 +712,15 @@ func StringGen(n int) Generator[string] {
 //
 // Panics if min > max.
 func Float64Gen(min, max float64) Generator[float64] {
+	return float64Gen(min, max, defaultRand.Float64)
+}
+
+func float64Gen(min, max float64, float func() float64) Generator[float64] {
 	if min > max {
 		panic(fmt.Sprintf("min (%f) must be <= max (%f)", min, max))
 	}
 	return func() float64 {
-		return min + rand.Float64()*(max-min)
+		return min + float()*(max-min)
 	}
 }
 
@@ -504,8 +731,12 @@ func Float64Gen(min, max float64) Generator[float64] {
 //	gen := lawtest.BoolGen()
 //	flag := gen() // true or false with equal probability
 func BoolGen() Generator[bool] {
+	return boolGen(defaultRand.Intn)
+}
+
+func boolGen(intn func(int) int) Generator[bool] {
 	return func() bool {
-		return rand.Intn(2) == 1
+		return intn(2) == 1
 	}
 }
 
@@ -701,6 +932,10 @@ type Homomorphism[T, U comparable] interface {
 //   - Inverse: a ∘ a⁻¹ = a⁻¹ ∘ a = e
 //   - Closure: type consistency
 //
+// Groups implementing FiniteGroup are checked exhaustively over their
+// Domain instead of against random samples, as far as it is small enough
+// (see FiniteGroup).
+//
 // Example:
 //
 //	func TestMyGroup(t *testing.T) {
@@ -715,23 +950,72 @@ func TestGroup[T comparable](t *testing.T, g Group[T]) {
 func TestGroupWithConfig[T comparable](t *testing.T, g Group[T], cfg *Config) {
 	t.Helper()
 
+	report := CheckGroup(g, pinSeed(t, cfg))
+
 	t.Run("Associativity", func(t *testing.T) {
-		AssociativeWithConfig(t, g.Op, g.Gen, cfg)
+		report.Associativity.report(t)
 	})
 
 	t.Run("Identity", func(t *testing.T) {
-		IdentityWithConfig(t, g.Op, g.Identity(), g.Gen, cfg)
+		report.Identity.report(t)
 	})
 
 	t.Run("Inverse", func(t *testing.T) {
-		InverseWithConfig(t, g.Op, g.Inverse, g.Identity(), g.Gen, cfg)
+		report.Inverse.report(t)
 	})
 
 	t.Run("Closure", func(t *testing.T) {
-		Closure(t, g.Op, g.Gen)
+		if report.Closure.report(t) {
+			t.Logf("✓ Closure property holds (enforced by Go's type system)")
+		}
 	})
 }
 
+// GroupReport holds the outcome of every group law checked by CheckGroup.
+type GroupReport[T any] struct {
+	Associativity Result[T] // (a ∘ b) ∘ c = a ∘ (b ∘ c)
+	Identity      Result[T] // a ∘ e = e ∘ a = a
+	Inverse       Result[T] // a ∘ a⁻¹ = a⁻¹ ∘ a = e
+	Closure       Result[T] // a ∘ b stays in T
+}
+
+// Passed reports whether every law held.
+func (r GroupReport[T]) Passed() bool {
+	return r.Associativity.Passed && r.Identity.Passed && r.Inverse.Passed && r.Closure.Passed
+}
+
+// CheckGroup checks every group law without a *testing.T and returns a
+// per-law report.
+//
+// Every law is checked even if an earlier one fails, so the report shows
+// the complete picture; TestGroup renders the same report as subtests.
+//
+// If g implements FiniteGroup, every law is checked exhaustively over its
+// Domain and cfg.TestCases is ignored, unless the law has too many
+// combinations to enumerate (see FiniteGroup). Otherwise, if g implements
+// Shrinkable[T] and cfg has no Shrinker, counterexamples are shrunk with g.
+//
+// Example:
+//
+//	report := lawtest.CheckGroup[int](ModAdd{n: 12}, lawtest.DefaultConfig())
+//	if !report.Passed() {
+//	    fmt.Println("inverse holds:", report.Inverse.Passed, report.Inverse.Counterexample)
+//	}
+func CheckGroup[T comparable](g Group[T], cfg *Config) GroupReport[T] {
+	if f, ok := g.(FiniteGroup[T]); ok {
+		return checkFiniteGroup(f, cfg)
+	}
+
+	cfg = shrinkingConfig[T](cfg, g)
+
+	return GroupReport[T]{
+		Associativity: CheckAssociative(g.Op, g.Gen, cfg),
+		Identity:      CheckIdentity(g.Op, g.Identity(), g.Gen, cfg),
+		Inverse:       CheckInverse(g.Op, g.Inverse, g.Identity(), g.Gen, cfg),
+		Closure:       CheckClosure(g.Op, g.Gen, cfg),
+	}
+}
+
 // TestMonoid verifies all monoid properties (associativity and identity).
 //
 // Tests performed:
@@ -753,6 +1037,8 @@ func TestMonoid[T comparable](t *testing.T, m Monoid[T]) {
 func TestMonoidWithConfig[T comparable](t *testing.T, m Monoid[T], cfg *Config) {
 	t.Helper()
 
+	cfg = shrinkingConfig[T](cfg, m)
+
 	t.Run("Associativity", func(t *testing.T) {
 		AssociativeWithConfig(t, m.Op, m.Gen, cfg)
 	})
@@ -762,7 +1048,7 @@ func TestMonoidWithConfig[T comparable](t *testing.T, m Monoid[T], cfg *Config)
 	})
 
 	t.Run("Closure", func(t *testing.T) {
-		Closure(t, m.Op, m.Gen)
+		ClosureWithConfig(t, m.Op, m.Gen, cfg)
 	})
 }
 
@@ -786,12 +1072,14 @@ func TestSemigroup[T comparable](t *testing.T, s Semigroup[T]) {
 func TestSemigroupWithConfig[T comparable](t *testing.T, s Semigroup[T], cfg *Config) {
 	t.Helper()
 
+	cfg = shrinkingConfig[T](cfg, s)
+
 	t.Run("Associativity", func(t *testing.T) {
 		AssociativeWithConfig(t, s.Op, s.Gen, cfg)
 	})
 
 	t.Run("Closure", func(t *testing.T) {
-		Closure(t, s.Op, s.Gen)
+		ClosureWithConfig(t, s.Op, s.Gen, cfg)
 	})
 }
 
@@ -811,6 +1099,8 @@ func TestIdempotentOp[T comparable](t *testing.T, op IdempotentOp[T]) {
 func TestIdempotentOpWithConfig[T comparable](t *testing.T, op IdempotentOp[T], cfg *Config) {
 	t.Helper()
 
+	cfg = shrinkingConfig[T](cfg, op)
+
 	t.Run("Idempotence", func(t *testing.T) {
 		IdempotentWithConfig(t, op.Apply, op.Gen, cfg)
 	})
@@ -841,7 +1131,7 @@ func TestHomomorphismWithConfig[T, U comparable](t *testing.T, h Homomorphism[T,
 
 	t.Run("PreservesOperation", func(t *testing.T) {
 		// Verify: h(a ∘ b) = h(a) ∘ h(b)
-		for i := 0; i < cfg.TestCases; i++ {
+		checkCases(t, cfg, func(int) string {
 			a := srcGroup.Gen()
 			b := srcGroup.Gen()
 
@@ -855,11 +1145,12 @@ func TestHomomorphismWithConfig[T, U comparable](t *testing.T, h Homomorphism[T,
 			haHb := tgtGroup.Op(ha, hb)
 
 			if hAb != haHb {
-				t.Errorf("Homomorphism failed: h(a∘b) != h(a)∘h(b)\n  a=%v, b=%v\n  h(a∘b)=%v, h(a)∘h(b)=%v",
+				return cfg.sprintf("Homomorphism failed: h(a∘b) != h(a)∘h(b)\n  a=%v, b=%v\n  h(a∘b)=%v, h(a)∘h(b)=%v",
 					a, b, hAb, haHb)
-				return
 			}
-		}
+
+			return ""
+		})
 	})
 
 	t.Run("PreservesIdentity", func(t *testing.T) {
@@ -876,43 +1167,104 @@ func TestHomomorphismWithConfig[T, U comparable](t *testing.T, h Homomorphism[T,
 	})
 }
 
+// TestHomomorphismCustom verifies that a map preserves structure, comparing
+// target values with a custom equality function.
+// Use this when the target type is not comparable (slices, maps, sets).
+//
+// The structures are given as operations and identities rather than as a
+// Homomorphism, since Group requires comparable elements.
+//
+// Tests performed:
+//   - Preserves operation: h(a ∘ b) = h(a) ∘ h(b)
+//   - Preserves identity: h(e_source) = e_target
+//
+// Example:
+//
+//	// Bitmasks under OR map to sets of bit positions under union
+//	bits := func(n int) map[int]bool { ... }
+//	union := func(a, b map[int]bool) map[int]bool { ... }
+//	or := func(a, b int) int { return a | b }
+//	lawtest.TestHomomorphismCustom(t, bits, or, 0, union, map[int]bool{},
+//	    lawtest.IntGen(0, 255), maps.Equal[map[int]bool, map[int]bool])
+func TestHomomorphismCustom[T, U any](t *testing.T, h func(T) U, srcOp BinaryOp[T], srcIdentity T, tgtOp BinaryOp[U], tgtIdentity U, gen Generator[T], eq func(U, U) bool) {
+	TestHomomorphismCustomWithConfig(t, h, srcOp, srcIdentity, tgtOp, tgtIdentity, gen, eq, DefaultConfig())
+}
+
+// TestHomomorphismCustomWithConfig verifies homomorphism properties with custom equality and configuration.
+func TestHomomorphismCustomWithConfig[T, U any](t *testing.T, h func(T) U, srcOp BinaryOp[T], srcIdentity T, tgtOp BinaryOp[U], tgtIdentity U, gen Generator[T], eq func(U, U) bool, cfg *Config) {
+	t.Helper()
+
+	t.Run("PreservesOperation", func(t *testing.T) {
+		// Verify: h(a ∘ b) = h(a) ∘ h(b)
+		checkCases(t, cfg, func(int) string {
+			a := gen()
+			b := gen()
+
+			// Left side: h(a ∘ b)
+			hAb := h(srcOp(a, b))
+
+			// Right side: h(a) ∘ h(b)
+			haHb := tgtOp(h(a), h(b))
+
+			if !eq(hAb, haHb) {
+				return cfg.sprintf("Homomorphism failed: h(a∘b) != h(a)∘h(b)\n  a=%v, b=%v\n  h(a∘b)=%v, h(a)∘h(b)=%v",
+					a, b, hAb, haHb)
+			}
+
+			return ""
+		})
+	})
+
+	t.Run("PreservesIdentity", func(t *testing.T) {
+		// Verify: h(e_source) = e_target
+		mappedIdentity := h(srcIdentity)
+
+		if !eq(mappedIdentity, tgtIdentity) {
+			t.Errorf("Homomorphism doesn't preserve identity: h(e_src) != e_tgt\n  e_src=%v, e_tgt=%v, h(e_src)=%v",
+				srcIdentity, tgtIdentity, mappedIdentity)
+		}
+	})
+}
+
 // ===========================================================================
 // HELPER: TEST THAT A STRUCT *FAILS* GROUP PROPERTIES (for negative testing)
 // ===========================================================================
 
 // ExpectGroupFailure runs group tests expecting them to FAIL
 // Useful for verifying that broken implementations are correctly detected
-func ExpectGroupFailure[T comparable](t *testing.T, g Group[T], expectedFailure string) {
+func ExpectGroupFailure[T comparable](t TB, g Group[T], expectedFailure string) {
 	t.Helper()
 
-	// Create a sub-test that we expect to fail
-	failed := false
-
-	testFunc := func(t *testing.T) {
-		TestGroup(t, g)
-	}
+	// Run every group property against a recorder instead of t, so the
+	// expected failures don't fail the calling test
+	rec := &recorder{}
+	cfg := DefaultConfig()
 
-	// Run test and capture if it failed
-	result := testing.RunTests(func(pat, str string) (bool, error) {
-		return true, nil
-	}, []testing.InternalTest{
-		{
-			Name: "ExpectedFailure",
-			F:    testFunc,
-		},
-	})
-
-	if !result {
-		failed = true
-	}
+	AssociativeWithConfig(rec, g.Op, g.Gen, cfg)
+	IdentityWithConfig(rec, g.Op, g.Identity(), g.Gen, cfg)
+	InverseWithConfig(rec, g.Op, g.Inverse, g.Identity(), g.Gen, cfg)
+	ClosureWithConfig(rec, g.Op, g.Gen, cfg)
 
-	if !failed {
+	if len(rec.errors) == 0 {
 		t.Errorf("Expected group test to fail with '%s', but it passed!", expectedFailure)
 	} else {
-		t.Logf("✓ Group correctly failed validation (as expected: %s)", expectedFailure)
+		t.Logf("✓ Group correctly failed validation (as expected: %s)\n  %s", expectedFailure, rec.errors[0])
 	}
 }
 
+// recorder is a TB that collects failures instead of reporting them.
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Logf(format string, args ...any) {}
+
 // ===========================================================================
 // CONCURRENCY SAFETY TESTING
 // ===========================================================================
@@ -923,9 +1275,18 @@ func ExpectGroupFailure[T comparable](t *testing.T, g Group[T], expectedFailure
 // Immutable operations (pure functions) should pass. Mutating operations
 // will exhibit race conditions under concurrent access.
 //
-// The test launches multiple goroutines that execute the operation
-// simultaneously on shared data. Race conditions, panics, or data corruption
-// indicate the operation is not parallel-safe.
+// For every test case the generator produces a pair of inputs that all
+// goroutines share, and the operation is started on them simultaneously.
+// The test fails if any goroutine panics or if the goroutines' results
+// differ. Results are compared with reflect.DeepEqual, so pointer types are
+// compared by the values they point to.
+//
+// If T holds no pointers, slices, maps or other references, each result is
+// also compared with a sequential run on copies of the inputs, and the
+// shared inputs are checked for mutation afterwards. Copying a reference
+// type such as *Cache doesn't copy what it points to, so those checks need
+// a clone func; use ParallelSafeDeep to provide one. ParallelSafe logs when
+// it has to skip them.
 //
 // Example:
 //
@@ -942,59 +1303,78 @@ func ExpectGroupFailure[T comparable](t *testing.T, g Group[T], expectedFailure
 // For production use, always run tests with -race flag:
 //
 //	go test -race
-func ParallelSafe[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T], goroutines int) bool {
+func ParallelSafe[T comparable](t TB, op BinaryOp[T], gen Generator[T], goroutines int) bool {
 	return ParallelSafeWithConfig(t, op, gen, goroutines, DefaultConfig())
 }
 
 // ParallelSafeWithConfig tests parallel safety with custom configuration.
-func ParallelSafeWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T], goroutines int, cfg *Config) bool {
+func ParallelSafeWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], goroutines int, cfg *Config) bool {
 	t.Helper()
 
 	if goroutines < 2 {
 		goroutines = 10 // Default to 10 goroutines
 	}
 
-	// Create shared test data
-	testData := make([]T, cfg.TestCases)
-	for i := range testData {
-		testData[i] = gen()
+	eq := func(a, b T) bool { return reflect.DeepEqual(a, b) }
+	clone := copyClone[T]()
+	if clone == nil {
+		logNoClone[T](t)
 	}
 
-	// Run operations concurrently
-	done := make(chan bool, goroutines)
-	errors := make(chan string, goroutines)
-
-	for g := 0; g < goroutines; g++ {
-		go func(id int) {
-			defer func() {
-				if r := recover(); r != nil {
-					errors <- fmt.Sprintf("Goroutine %d panicked: %v", id, r)
-				}
-				done <- true
-			}()
-
-			// Perform operations on shared data
-			for i := 0; i < len(testData)-1; i++ {
-				_ = op(testData[i], testData[i+1])
-			}
-		}(g)
+	cfg = pinSeed(t, cfg)
+	logIgnoredOptions(t, cfg)
+	run := runSeeded(cfg, func(int) string {
+		a, b := gen(), gen()
+		return parallelCase(op, a, b, clone, eq, goroutines)
+	})
+	if run.panicked {
+		run.failures = []string{cfg.sprintf("Parallel safety failed: operation panicked: %v", run.panicValue)}
 	}
 
-	// Wait for all goroutines
-	for i := 0; i < goroutines; i++ {
-		<-done
+	if msg := run.message(cfg); msg != "" {
+		t.Logf("⚠ Race condition detected: %s\n  %s", msg, run.reproduce())
+		t.Logf("❌ Operation is NOT parallel-safe (race conditions detected)")
+		return false
 	}
 
-	// Check for errors
-	close(errors)
-	hasErrors := false
-	for err := range errors {
-		t.Logf("⚠ Race condition detected: %s", err)
-		hasErrors = true
+	t.Logf("✅ Operation appears parallel-safe (no race conditions in %d goroutines)", goroutines)
+	return true
+}
+
+// ParallelSafeDeep tests parallel safety of reference types using a
+// user-supplied clone.
+//
+// It runs the same checks as ParallelSafe, comparing results and inputs
+// with eq, but builds the sequential reference and the snapshot of the
+// shared inputs with clone, so that an operation mutating what its inputs
+// point to, like a cache merge that writes into its receiver, is caught.
+// Unlike ParallelSafe it fails the test instead of only reporting the
+// outcome.
+//
+// Example:
+//
+//	func TestCacheMergeParallel(t *testing.T) {
+//	    merge := func(a, b *Cache) *Cache { return a.Merge(b) }
+//	    clone := func(c *Cache) *Cache { return &Cache{data: maps.Clone(c.data)} }
+//	    eq := func(a, b *Cache) bool { return maps.Equal(a.data, b.data) }
+//	    lawtest.ParallelSafeDeep(t, merge, NewRandomCache, clone, eq, 20)
+//	}
+func ParallelSafeDeep[T any](t TB, op BinaryOp[T], gen Generator[T], clone func(T) T, eq func(T, T) bool, goroutines int) bool {
+	return ParallelSafeDeepWithConfig(t, op, gen, clone, eq, goroutines, DefaultConfig())
+}
+
+// ParallelSafeDeepWithConfig tests deep parallel safety with custom configuration.
+func ParallelSafeDeepWithConfig[T any](t TB, op BinaryOp[T], gen Generator[T], clone func(T) T, eq func(T, T) bool, goroutines int, cfg *Config) bool {
+	t.Helper()
+
+	if goroutines < 2 {
+		goroutines = 10 // Default to 10 goroutines
 	}
 
-	if hasErrors {
-		t.Logf("❌ Operation is NOT parallel-safe (race conditions detected)")
+	if !checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+		return parallelCase(op, a, b, clone, eq, goroutines)
+	}) {
 		return false
 	}
 
@@ -1002,6 +1382,194 @@ func ParallelSafeWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Gene
 	return true
 }
 
+// parallelCase runs op from goroutines goroutines at once on the shared
+// inputs a and b and describes the first sign of unsafe sharing, or returns
+// "".
+//
+// With a clone func every result is compared with a sequential run on
+// clones of the inputs, and the inputs with clones taken beforehand. Without
+// one the results can only be compared with each other.
+func parallelCase[T any](op BinaryOp[T], a, b T, clone func(T) T, eq func(T, T) bool, goroutines int) string {
+	origA, origB := a, b
+	var expected T
+	if clone != nil {
+		origA, origB = clone(a), clone(b)
+		expected = op(clone(a), clone(b))
+	}
+
+	results, panics := runConcurrently(op, a, b, goroutines)
+
+	for g := range results {
+		if panics[g] != nil {
+			return fmt.Sprintf("Parallel safety failed: goroutine %d panicked: %v\n  a=%v, b=%v",
+				g, panics[g], origA, origB)
+		}
+	}
+
+	if clone == nil {
+		for g := 1; g < len(results); g++ {
+			if !eq(results[g], results[0]) {
+				return fmt.Sprintf("Parallel safety failed: goroutines 0 and %d produced different results\n  a=%v, b=%v\n  goroutine 0=%v, goroutine %d=%v",
+					g, origA, origB, results[0], g, results[g])
+			}
+		}
+		return ""
+	}
+
+	for g := range results {
+		if !eq(results[g], expected) {
+			return fmt.Sprintf("Parallel safety failed: goroutine %d produced a different result than a sequential run\n  a=%v, b=%v\n  expected=%v, got=%v",
+				g, origA, origB, expected, results[g])
+		}
+	}
+
+	if !eq(a, origA) || !eq(b, origB) {
+		return fmt.Sprintf("Parallel safety failed: shared inputs were mutated\n  before: a=%v, b=%v\n  after:  a=%v, b=%v",
+			origA, origB, a, b)
+	}
+
+	return ""
+}
+
+// copyClone returns a clone func for T if copying a T copies everything it
+// holds, that is if T contains no pointers, slices, maps or other
+// references, and nil otherwise.
+func copyClone[T any]() func(T) T {
+	if !copiesByValue(reflect.TypeOf((*T)(nil)).Elem()) {
+		return nil
+	}
+	return func(v T) T { return v }
+}
+
+// copiesByValue reports whether copying a value of type t copies everything
+// it holds.
+func copiesByValue(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Array:
+		return copiesByValue(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !copiesByValue(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	case reflect.String:
+		return true
+	default:
+		return t.Kind() >= reflect.Bool && t.Kind() <= reflect.Complex128
+	}
+}
+
+// logNoClone logs that the parallel safety checks that need copies of the
+// inputs are skipped, because T can't be copied without a clone func.
+func logNoClone[T any](t TB) {
+	t.Helper()
+
+	t.Logf("lawtest: %v holds references, so results are only compared with each other and the inputs aren't checked for mutation; use ParallelSafeDeep with a clone func to check both",
+		reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// runConcurrently starts op(a, b) on goroutines goroutines at the same
+// moment and returns each goroutine's result and recovered panic, if any.
+func runConcurrently[T any](op BinaryOp[T], a, b T, goroutines int) ([]T, []any) {
+	results := make([]T, goroutines)
+	panics := make([]any, goroutines)
+	start := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			defer func() { panics[id] = recover() }()
+
+			<-start
+			results[id] = op(a, b)
+		}(g)
+	}
+	close(start)
+	wg.Wait()
+
+	return results, panics
+}
+
+// DeterministicUnderConcurrency tests that an operation gives the same
+// result when many goroutines run it on the same inputs at once.
+//
+// Every round draws a fresh pair a, b and runs op(a, b) from goroutines
+// goroutines simultaneously; each result must equal the first. The test
+// runs all reps rounds and reports how many of them diverged.
+//
+// Unlike the race detector, this is a logical check: it catches output
+// that depends on map iteration order, shared counters or other hidden
+// state even when every access is properly synchronized.
+//
+// Example:
+//
+//	func TestRenderDeterministic(t *testing.T) {
+//	    join := func(a, b Tags) Tags { return a.Join(b) }
+//	    lawtest.DeterministicUnderConcurrency(t, join, genTags, 20, 50)
+//	}
+func DeterministicUnderConcurrency[T comparable](t TB, op BinaryOp[T], gen Generator[T], goroutines, reps int) {
+	DeterministicUnderConcurrencyWithConfig(t, op, gen, goroutines, reps, DefaultConfig())
+}
+
+// DeterministicUnderConcurrencyWithConfig tests concurrent determinism with custom configuration.
+//
+// reps takes the place of cfg.TestCases; the other settings apply as usual.
+func DeterministicUnderConcurrencyWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], goroutines, reps int, cfg *Config) {
+	t.Helper()
+
+	if goroutines < 2 {
+		goroutines = 10 // Default to 10 goroutines
+	}
+
+	rounds := *cfg
+	rounds.TestCases = reps
+	cfg = pinSeed(t, &rounds)
+	logIgnoredOptions(t, cfg)
+
+	var (
+		diverged int
+		first    string
+	)
+	run := runSeeded(cfg, func(int) string {
+		a, b := gen(), gen()
+		results, panics := runConcurrently(op, a, b, goroutines)
+
+		for g := range results {
+			var msg string
+			switch {
+			case panics[g] != nil:
+				msg = cfg.sprintf("  a=%v, b=%v\n  goroutine %d panicked: %v", a, b, g, panics[g])
+			case panics[0] == nil && results[g] != results[0]:
+				msg = cfg.sprintf("  a=%v, b=%v\n  goroutine 0 got=%v, goroutine %d got=%v", a, b, results[0], g, results[g])
+			default:
+				continue
+			}
+
+			if diverged == 0 {
+				first = msg
+			}
+			diverged++
+			break
+		}
+
+		return ""
+	})
+
+	if run.timedOut || run.canceled != nil || run.panicked {
+		run.report(t, cfg)
+		return
+	}
+
+	if diverged > 0 {
+		t.Errorf("Determinism failed: %d of %d rounds diverged across %d goroutines\n%s\n  %s",
+			diverged, reps, goroutines, first, run.reproduce())
+	}
+}
+
 // TestParallelAssociativity tests if associativity holds under concurrent execution.
 //
 // This is a stronger test than Associative: not only must (a∘b)∘c = a∘(b∘c),
@@ -1011,6 +1579,8 @@ func ParallelSafeWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Gene
 //
 // The test first verifies sequential associativity, then launches multiple
 // goroutines that test associativity simultaneously.
+// The goroutines share gen through SyncGen, so it need not be safe for
+// concurrent use itself.
 //
 // Example:
 //
@@ -1031,73 +1601,128 @@ func TestParallelAssociativity[T comparable](t *testing.T, op BinaryOp[T], gen G
 func TestParallelAssociativityWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T], goroutines int, cfg *Config) {
 	t.Helper()
 
-	if goroutines < 2 {
-		goroutines = 10
-	}
-
 	// First verify sequential associativity
 	t.Run("Sequential", func(t *testing.T) {
 		AssociativeWithConfig(t, op, gen, cfg)
 	})
 
-	// Then test under concurrent load
+	// Then test under concurrent load, with every goroutine drawing from gen
+	gen = SyncGen(gen)
 	t.Run("Concurrent", func(t *testing.T) {
-		type testCase struct {
-			a, b, c T
-			left    T
-			right   T
-		}
+		parallelAssociativity(t, op, func(int) Generator[T] { return gen }, goroutines, cfg)
+	})
+}
+
+// TestParallelAssociativitySeeded tests parallel associativity with a
+// separate, deterministically seeded generator for every goroutine.
+//
+// newGen is called once per goroutine with a *rand.Rand seeded from the
+// run's seed plus one plus the goroutine's index, so goroutines never
+// contend for a shared source and a failing run replays from the seed it
+// reports, down to which goroutine drew which inputs. The sequential check
+// draws from newGen seeded with the run's seed itself, so no goroutine
+// repeats its inputs.
+//
+// Example:
+//
+//	func TestCacheMergeConcurrent(t *testing.T) {
+//	    newGen := func(r *rand.Rand) lawtest.Generator[int] {
+//	        return lawtest.IntGenSeeded(-1000, 1000, r)
+//	    }
+//	    lawtest.TestParallelAssociativitySeeded(t, merge, newGen, 20)
+//	}
+func TestParallelAssociativitySeeded[T comparable](t *testing.T, op BinaryOp[T], newGen func(*rand.Rand) Generator[T], goroutines int) {
+	TestParallelAssociativitySeededWithConfig(t, op, newGen, goroutines, DefaultConfig())
+}
 
-		results := make(chan testCase, cfg.TestCases)
-		done := make(chan bool, goroutines)
+// TestParallelAssociativitySeededWithConfig tests seeded parallel associativity with custom configuration.
+func TestParallelAssociativitySeededWithConfig[T comparable](t *testing.T, op BinaryOp[T], newGen func(*rand.Rand) Generator[T], goroutines int, cfg *Config) {
+	t.Helper()
 
-		// Launch goroutines to test associativity concurrently
-		casesPerGoroutine := cfg.TestCases / goroutines
-		if casesPerGoroutine == 0 {
-			casesPerGoroutine = 1
-		}
+	cfg = pinSeed(t, cfg)
 
-		for g := 0; g < goroutines; g++ {
-			go func() {
-				defer func() { done <- true }()
+	t.Run("Sequential", func(t *testing.T) {
+		AssociativeWithConfig(t, op, newGen(NewRand(cfg.Seed)), cfg)
+	})
 
-				for i := 0; i < casesPerGoroutine; i++ {
-					a, b, c := gen(), gen(), gen()
-					left := op(op(a, b), c)
-					right := op(a, op(b, c))
+	t.Run("Concurrent", func(t *testing.T) {
+		parallelAssociativity(t, op, func(g int) Generator[T] {
+			return newGen(NewRand(cfg.Seed + 1 + int64(g)))
+		}, goroutines, cfg)
 
-					results <- testCase{a, b, c, left, right}
-				}
-			}()
+		if t.Failed() {
+			t.Logf("lawtest: seed=%d (set Config.Seed or -lawtest.seed to reproduce)", cfg.Seed)
 		}
+	})
+}
+
+// parallelAssociativity checks associativity from goroutines goroutines
+// at once, goroutine g drawing its operands from genFor(g).
+func parallelAssociativity[T comparable](t *testing.T, op BinaryOp[T], genFor func(g int) Generator[T], goroutines int, cfg *Config) {
+	t.Helper()
+
+	if goroutines < 2 {
+		goroutines = 10
+	}
+
+	type testCase struct {
+		g       int
+		a, b, c T
+		left    T
+		right   T
+	}
+
+	results := make(chan testCase, cfg.TestCases)
+	done := make(chan bool, goroutines)
+
+	// Launch goroutines to test associativity concurrently
+	casesPerGoroutine := cfg.TestCases / goroutines
+	if casesPerGoroutine == 0 {
+		casesPerGoroutine = 1
+	}
 
-		// Wait for all goroutines
-		go func() {
-			for i := 0; i < goroutines; i++ {
-				<-done
+	for g := 0; g < goroutines; g++ {
+		gen := genFor(g)
+		go func(g int) {
+			defer func() { done <- true }()
+
+			for i := 0; i < casesPerGoroutine; i++ {
+				v := drawDistinct(cfg, gen, 3)
+				a, b, c := v[0], v[1], v[2]
+				left := op(op(a, b), c)
+				right := op(a, op(b, c))
+
+				results <- testCase{g, a, b, c, left, right}
 			}
-			close(results)
-		}()
-
-		// Check results
-		failures := 0
-		for tc := range results {
-			if tc.left != tc.right {
-				t.Errorf("Associativity failed under concurrency: (a∘b)∘c != a∘(b∘c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
-					tc.a, tc.b, tc.c, tc.left, tc.right)
-				failures++
-				if failures >= 3 {
-					break
-				}
+		}(g)
+	}
+
+	// Wait for all goroutines
+	go func() {
+		for i := 0; i < goroutines; i++ {
+			<-done
+		}
+		close(results)
+	}()
+
+	// Check results
+	failures := 0
+	for tc := range results {
+		if tc.left != tc.right {
+			t.Errorf("Associativity failed under concurrency: (a∘b)∘c != a∘(b∘c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v (goroutine %d)",
+				tc.a, tc.b, tc.c, tc.left, tc.right, tc.g)
+			failures++
+			if failures >= 3 {
+				break
 			}
 		}
+	}
 
-		if failures == 0 {
-			t.Logf("✅ Associativity holds under concurrent execution (%d goroutines)", goroutines)
-		} else {
-			t.Logf("❌ Associativity violated under concurrency (%d failures)", failures)
-		}
-	})
+	if failures == 0 {
+		t.Logf("✅ Associativity holds under concurrent execution (%d goroutines)", goroutines)
+	} else {
+		t.Logf("❌ Associativity violated under concurrency (%d failures)", failures)
+	}
 }
 
 // ImmutableOp tests if an operation creates new values instead of mutating inputs.
@@ -1125,16 +1750,17 @@ func TestParallelAssociativityWithConfig[T comparable](t *testing.T, op BinaryOp
 //	}
 //
 // This catches mutations that violate functional programming principles.
-func ImmutableOp[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T]) {
+func ImmutableOp[T comparable](t TB, op BinaryOp[T], gen Generator[T]) {
 	ImmutableOpWithConfig(t, op, gen, DefaultConfig())
 }
 
 // ImmutableOpWithConfig tests immutability with custom configuration.
-func ImmutableOpWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Generator[T], cfg *Config) {
+func ImmutableOpWithConfig[T comparable](t TB, op BinaryOp[T], gen Generator[T], cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
-		a, b := gen(), gen()
+	if !checkCases(t, cfg, func(int) string {
+		v := drawDistinct(cfg, gen, 2)
+		a, b := v[0], v[1]
 
 		// Create copies for comparison (for comparable types)
 		aOriginal := a
@@ -1145,16 +1771,72 @@ func ImmutableOpWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Gener
 
 		// Check if inputs were mutated
 		if a != aOriginal {
-			t.Errorf("Immutability violated: operation mutated first argument\n  before=%v, after=%v",
+			return cfg.sprintf("Immutability violated: operation mutated first argument\n  before=%v, after=%v",
 				aOriginal, a)
-			return
 		}
 
 		if b != bOriginal {
-			t.Errorf("Immutability violated: operation mutated second argument\n  before=%v, after=%v",
+			return cfg.sprintf("Immutability violated: operation mutated second argument\n  before=%v, after=%v",
 				bOriginal, b)
-			return
 		}
+
+		return ""
+	}) {
+		return
+	}
+
+	t.Logf("✅ Operation is immutable (does not mutate inputs)")
+}
+
+// ImmutableOpDeep tests immutability of reference types using a user-supplied clone.
+//
+// ImmutableOp compares inputs with ==, which for pointers, slices, and maps
+// compares references rather than contents, so in-place mutation goes
+// undetected. ImmutableOpDeep snapshots each input with clone before the
+// operation runs and compares the post-operation input against the snapshot
+// with eq.
+//
+// Example:
+//
+//	func TestCacheMergeImmutable(t *testing.T) {
+//	    merge := func(a, b *Cache) *Cache { return a.Merge(b) }
+//	    gen := func() *Cache { return NewRandomCache() }
+//	    clone := func(c *Cache) *Cache { return &Cache{data: maps.Clone(c.data)} }
+//	    eq := func(a, b *Cache) bool { return maps.Equal(a.data, b.data) }
+//	    lawtest.ImmutableOpDeep(t, merge, gen, clone, eq)
+//	}
+func ImmutableOpDeep[T any](t TB, op BinaryOp[T], gen Generator[T], clone func(T) T, eq func(T, T) bool) {
+	ImmutableOpDeepWithConfig(t, op, gen, clone, eq, DefaultConfig())
+}
+
+// ImmutableOpDeepWithConfig tests deep immutability with custom configuration.
+func ImmutableOpDeepWithConfig[T any](t TB, op BinaryOp[T], gen Generator[T], clone func(T) T, eq func(T, T) bool, cfg *Config) {
+	t.Helper()
+
+	if !checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+
+		// Snapshot contents before the operation can touch them
+		aSnapshot := clone(a)
+		bSnapshot := clone(b)
+
+		// Apply operation
+		_ = op(a, b)
+
+		// Compare what the inputs hold now against the snapshots
+		if !eq(a, aSnapshot) {
+			return cfg.sprintf("Immutability violated: operation mutated first argument\n  before=%v, after=%v",
+				aSnapshot, a)
+		}
+
+		if !eq(b, bSnapshot) {
+			return cfg.sprintf("Immutability violated: operation mutated second argument\n  before=%v, after=%v",
+				bSnapshot, b)
+		}
+
+		return ""
+	}) {
+		return
 	}
 
 	t.Logf("✅ Operation is immutable (does not mutate inputs)")
@@ -1170,28 +1852,34 @@ func ImmutableOpWithConfig[T comparable](t *testing.T, op BinaryOp[T], gen Gener
 //	gen := func() State { return State{items: []int{1,2,3}} }
 //	eq := func(a, b State) bool { return reflect.DeepEqual(a.items, b.items) }
 //	lawtest.AssociativeCustom(t, merge, gen, eq)
-func AssociativeCustom[T any](t *testing.T, op BinaryOp[T], gen Generator[T], eq func(T, T) bool) {
+func AssociativeCustom[T any](t TB, op BinaryOp[T], gen Generator[T], eq func(T, T) bool) {
 	AssociativeCustomWithConfig(t, op, gen, eq, DefaultConfig())
 }
 
 // AssociativeCustomWithConfig tests associativity with custom equality and configuration.
-func AssociativeCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, cfg *Config) {
+func AssociativeCustomWithConfig[T any](t TB, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
-		a, b, c := gen(), gen(), gen()
+	if !checkCases(t, cfg, func(int) string {
+		msg, _ := shrinkFailure(cfg, []T{gen(), gen(), gen()}, func(v []T) string {
+			a, b, c := v[0], v[1], v[2]
 
-		// (a ∘ b) ∘ c
-		left := op(op(a, b), c)
+			// (a ∘ b) ∘ c
+			left := op(op(a, b), c)
 
-		// a ∘ (b ∘ c)
-		right := op(a, op(b, c))
+			// a ∘ (b ∘ c)
+			right := op(a, op(b, c))
 
-		if !eq(left, right) {
-			t.Errorf("Associativity failed: (a∘b)∘c != a∘(b∘c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
-				a, b, c, left, right)
-			return
-		}
+			if !eq(left, right) {
+				return cfg.sprintf("Associativity failed: (a∘b)∘c != a∘(b∘c)\n  a=%v, b=%v, c=%v\n  left=%v, right=%v",
+					a, b, c, left, right) + cfg.diff(left, right)
+			}
+
+			return ""
+		})
+		return msg
+	}) {
+		return
 	}
 
 	t.Logf("✅ Operation is associative with custom equality")
@@ -1207,15 +1895,15 @@ func AssociativeCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Genera
 //	gen := func() State { return State{data: map[string]int{"x": 1}} }
 //	eq := func(a, b State) bool { return reflect.DeepEqual(a.data, b.data) }
 //	lawtest.ImmutableOpCustom(t, merge, gen, eq)
-func ImmutableOpCustom[T any](t *testing.T, op BinaryOp[T], gen Generator[T], eq func(T, T) bool) {
+func ImmutableOpCustom[T any](t TB, op BinaryOp[T], gen Generator[T], eq func(T, T) bool) {
 	ImmutableOpCustomWithConfig(t, op, gen, eq, DefaultConfig())
 }
 
 // ImmutableOpCustomWithConfig tests immutability with custom equality and configuration.
-func ImmutableOpCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, cfg *Config) {
+func ImmutableOpCustomWithConfig[T any](t TB, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, cfg *Config) {
 	t.Helper()
 
-	for i := 0; i < cfg.TestCases; i++ {
+	if !checkCases(t, cfg, func(int) string {
 		a, b := gen(), gen()
 
 		// Create deep copies using custom serialization
@@ -1229,16 +1917,18 @@ func ImmutableOpCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Genera
 
 		// Check if inputs were mutated using custom equality
 		if !eq(a, aOriginal) {
-			t.Errorf("Immutability violated: operation mutated first argument\n  before=%v, after=%v",
+			return cfg.sprintf("Immutability violated: operation mutated first argument\n  before=%v, after=%v",
 				aOriginal, a)
-			return
 		}
 
 		if !eq(b, bOriginal) {
-			t.Errorf("Immutability violated: operation mutated second argument\n  before=%v, after=%v",
+			return cfg.sprintf("Immutability violated: operation mutated second argument\n  before=%v, after=%v",
 				bOriginal, b)
-			return
 		}
+
+		return ""
+	}) {
+		return
 	}
 
 	t.Logf("✅ Operation is immutable (does not mutate inputs)")
@@ -1247,6 +1937,11 @@ func ImmutableOpCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Genera
 // ParallelSafeCustom tests parallel safety using a custom equality function.
 // Use this for non-comparable types (slices, maps, functions).
 //
+// It runs the same checks as ParallelSafe, comparing results and inputs
+// with eq, but fails the test instead of only reporting the outcome. Use
+// ParallelSafeDeep for reference types, whose inputs need a clone func to be
+// checked for mutation.
+//
 // Example:
 //
 //	type Cache struct { data map[string]string }
@@ -1254,43 +1949,28 @@ func ImmutableOpCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Genera
 //	gen := func() Cache { return Cache{data: map[string]string{"x": "y"}} }
 //	eq := func(a, b Cache) bool { return reflect.DeepEqual(a.data, b.data) }
 //	lawtest.ParallelSafeCustom(t, merge, gen, eq, 100)
-func ParallelSafeCustom[T any](t *testing.T, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, goroutines int) bool {
+func ParallelSafeCustom[T any](t TB, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, goroutines int) bool {
 	return ParallelSafeCustomWithConfig(t, op, gen, eq, goroutines, DefaultConfig())
 }
 
 // ParallelSafeCustomWithConfig tests parallel safety with custom equality and configuration.
-func ParallelSafeCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, goroutines int, cfg *Config) bool {
+func ParallelSafeCustomWithConfig[T any](t TB, op BinaryOp[T], gen Generator[T], eq func(T, T) bool, goroutines int, cfg *Config) bool {
 	t.Helper()
 
-	// Generate test data
-	a, b := gen(), gen()
-
-	// Expected result (sequential)
-	expected := op(a, b)
-
-	// Run operation concurrently many times
-	results := make([]T, goroutines)
-	done := make(chan bool, goroutines)
-
-	for i := 0; i < goroutines; i++ {
-		go func(idx int) {
-			results[idx] = op(a, b)
-			done <- true
-		}(i)
+	if goroutines < 2 {
+		goroutines = 10 // Default to 10 goroutines
 	}
 
-	// Wait for all goroutines
-	for i := 0; i < goroutines; i++ {
-		<-done
+	clone := copyClone[T]()
+	if clone == nil {
+		logNoClone[T](t)
 	}
 
-	// Check if all results match expected using custom equality
-	for i, result := range results {
-		if !eq(result, expected) {
-			t.Errorf("Parallel safety failed: goroutine %d produced different result\n  expected=%v, got=%v",
-				i, expected, result)
-			return false
-		}
+	if !checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+		return parallelCase(op, a, b, clone, eq, goroutines)
+	}) {
+		return false
 	}
 
 	t.Logf("✅ Operation appears parallel-safe (no race conditions in %d goroutines)", goroutines)
@@ -1333,23 +2013,26 @@ func ParallelSafeCustomWithConfig[T any](t *testing.T, op BinaryOp[T], gen Gener
 //   - gen: generator function for random test inputs
 //
 // Returns true if both functions produce the same output for all test cases.
-func Equivalent[T any, R comparable](t *testing.T, f1, f2 func(T) R, gen func() T) bool {
+func Equivalent[T any, R comparable](t TB, f1, f2 func(T) R, gen func() T) bool {
 	t.Helper()
-	iterations := defaultTestCases
+	cfg := DefaultConfig()
 
-	for i := 0; i < iterations; i++ {
+	if !checkCases(t, cfg, func(i int) string {
 		input := gen()
 		result1 := f1(input)
 		result2 := f2(input)
 
 		if result1 != result2 {
-			t.Errorf("Functions not equivalent at iteration %d\n  input=%v\n  f1(input)=%v\n  f2(input)=%v",
+			return cfg.sprintf("Functions not equivalent at iteration %d\n  input=%v\n  f1(input)=%v\n  f2(input)=%v",
 				i, input, result1, result2)
-			return false
 		}
+
+		return ""
+	}) {
+		return false
 	}
 
-	t.Logf("✅ Functions are equivalent (tested %d random inputs)", iterations)
+	t.Logf("✅ Functions are equivalent (tested %d random inputs)", cfg.TestCases)
 	return true
 }
 
@@ -1395,22 +2078,262 @@ func Equivalent[T any, R comparable](t *testing.T, f1, f2 func(T) R, gen func()
 //   - eq: custom equality function for comparing outputs
 //
 // Returns true if both functions produce equal output for all test cases.
-func EquivalentCustom[T any, R any](t *testing.T, f1, f2 func(T) R, gen func() T, eq func(R, R) bool) bool {
+func EquivalentCustom[T any, R any](t TB, f1, f2 func(T) R, gen func() T, eq func(R, R) bool) bool {
+	t.Helper()
+	return EquivalentCustomWithConfig(t, f1, f2, gen, eq, DefaultConfig())
+}
+
+// EquivalentCustomWithConfig tests function equivalence with custom equality and configuration.
+//
+// Setting Config.Formatter to CompactFormatter keeps long slice outputs
+// readable and points at the first index where they diverge.
+func EquivalentCustomWithConfig[T any, R any](t TB, f1, f2 func(T) R, gen func() T, eq func(R, R) bool, cfg *Config) bool {
 	t.Helper()
-	iterations := defaultTestCases
 
-	for i := 0; i < iterations; i++ {
+	if !checkCases(t, cfg, func(i int) string {
 		input := gen()
 		result1 := f1(input)
 		result2 := f2(input)
 
 		if !eq(result1, result2) {
-			t.Errorf("Functions not equivalent at iteration %d\n  input=%v\n  f1(input)=%v\n  f2(input)=%v",
-				i, input, result1, result2)
-			return false
+			return cfg.sprintf("Functions not equivalent at iteration %d\n  input=%v\n  f1(input)=%v\n  f2(input)=%v",
+				i, input, result1, result2) + cfg.diff(result1, result2)
+		}
+
+		return ""
+	}) {
+		return false
+	}
+
+	t.Logf("✅ Functions are equivalent (tested %d random inputs)", cfg.TestCases)
+	return true
+}
+
+// EquivalentDeep tests if two functions produce the same output for all
+// inputs, comparing outputs with reflect.DeepEqual.
+//
+// It covers slice, map and struct outputs without a hand-written equality
+// function. reflect.DeepEqual is much slower than ==, so prefer Equivalent
+// for comparable output types. Note that DeepEqual distinguishes a nil
+// slice or map from an empty one.
+//
+// Example:
+//
+//	func TestReverseEquivalent(t *testing.T) {
+//	    gen := lawtest.SliceGen(lawtest.IntGen(0, 99), 0, 10)
+//	    lawtest.EquivalentDeep(t, ReverseRecursive, ReverseIterative, gen)
+//	}
+//
+// Returns true if both functions produce deeply equal output for all test cases.
+func EquivalentDeep[T any, R any](t TB, f1, f2 func(T) R, gen func() T) bool {
+	t.Helper()
+	return EquivalentCustom(t, f1, f2, gen, func(a, b R) bool { return reflect.DeepEqual(a, b) })
+}
+
+// Equivalent2 tests if two functions of two arguments produce the same
+// output for all inputs.
+//
+// It is Equivalent for functions like Power(base, exp), without packing the
+// arguments into a struct.
+//
+// Example:
+//
+//	lawtest.Equivalent2(t,
+//	    Power,
+//	    func(base, exp int) int { return PowerTail(base, exp, 1) },
+//	    lawtest.IntGen(1, 5), lawtest.IntGen(0, 9),
+//	)
+//
+// Returns true if both functions produce the same output for all test cases.
+func Equivalent2[A, B any, R comparable](t TB, f1, f2 func(A, B) R, genA Generator[A], genB Generator[B]) bool {
+	t.Helper()
+	cfg := DefaultConfig()
+
+	if !checkCases(t, cfg, func(i int) string {
+		a, b := genA(), genB()
+		result1 := f1(a, b)
+		result2 := f2(a, b)
+
+		if result1 != result2 {
+			return cfg.sprintf("Functions not equivalent at iteration %d\n  a=%v, b=%v\n  f1(a, b)=%v\n  f2(a, b)=%v",
+				i, a, b, result1, result2)
+		}
+
+		return ""
+	}) {
+		return false
+	}
+
+	t.Logf("✅ Functions are equivalent (tested %d random inputs)", cfg.TestCases)
+	return true
+}
+
+// Equivalent3 tests if two functions of three arguments produce the same
+// output for all inputs.
+//
+// Example:
+//
+//	lawtest.Equivalent3(t, ModPow, ModPowIterative,
+//	    lawtest.IntGen(0, 100), lawtest.IntGen(0, 20), lawtest.IntGen(1, 1000))
+//
+// Returns true if both functions produce the same output for all test cases.
+func Equivalent3[A, B, C any, R comparable](t TB, f1, f2 func(A, B, C) R, genA Generator[A], genB Generator[B], genC Generator[C]) bool {
+	t.Helper()
+	cfg := DefaultConfig()
+
+	if !checkCases(t, cfg, func(i int) string {
+		a, b, c := genA(), genB(), genC()
+		result1 := f1(a, b, c)
+		result2 := f2(a, b, c)
+
+		if result1 != result2 {
+			return cfg.sprintf("Functions not equivalent at iteration %d\n  a=%v, b=%v, c=%v\n  f1(a, b, c)=%v\n  f2(a, b, c)=%v",
+				i, a, b, c, result1, result2)
 		}
+
+		return ""
+	}) {
+		return false
 	}
 
-	t.Logf("✅ Functions are equivalent (tested %d random inputs)", iterations)
+	t.Logf("✅ Functions are equivalent (tested %d random inputs)", cfg.TestCases)
 	return true
 }
+
+// benchInputs is how many inputs BenchEquivalent generates up front, so
+// that generator cost stays out of the timings.
+const benchInputs = 1024
+
+// BenchEquivalent proves two functions equivalent, then benchmarks both.
+//
+// It first runs Equivalent on a random sample and stops the benchmark with
+// b.Fatal if the functions disagree, since timing a wrong implementation
+// is meaningless. It then benchmarks f1 and f2 as the sub-benchmarks "f1"
+// and "f2" over the same pre-generated inputs and logs the speedup of f2
+// over f1.
+//
+// Example:
+//
+//	func BenchmarkFactorial(b *testing.B) {
+//	    gen := func() int { return rand.Intn(20) + 1 }
+//	    lawtest.BenchEquivalent(b,
+//	        func(n int) int { return Factorial(n) },
+//	        func(n int) int { return FactorialTail(n, 1) },
+//	        gen,
+//	    )
+//	}
+//
+// Output looks like:
+//
+//	BenchmarkFactorial/f1    20000000    62.1 ns/op
+//	BenchmarkFactorial/f2    50000000    24.3 ns/op
+//	    factorial_test.go:12: speedup of f2 over f1: 2.56x (62.1 ns/op vs 24.3 ns/op)
+func BenchEquivalent[T any, R comparable](b *testing.B, f1, f2 func(T) R, gen func() T) {
+	b.Helper()
+
+	if !Equivalent(b, f1, f2, gen) {
+		b.Fatal("Functions are not equivalent; refusing to benchmark")
+	}
+
+	inputs := make([]T, benchInputs)
+	for i := range inputs {
+		inputs[i] = gen()
+	}
+
+	measure := func(f func(T) R, nsPerOp *float64) func(b *testing.B) {
+		return func(b *testing.B) {
+			var sink R
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				sink = f(inputs[i%len(inputs)])
+			}
+			b.StopTimer()
+			_ = sink
+
+			// The last run has the largest b.N and is the one reported
+			*nsPerOp = float64(b.Elapsed().Nanoseconds()) / float64(b.N)
+		}
+	}
+
+	var ns1, ns2 float64
+	b.Run("f1", measure(f1, &ns1))
+	b.Run("f2", measure(f2, &ns2))
+
+	if ns1 > 0 && ns2 > 0 {
+		b.Logf("speedup of f2 over f1: %.2fx (%.1f ns/op vs %.1f ns/op)", ns1/ns2, ns1, ns2)
+	}
+}
+
+// timingRounds is how many times EquivalentFaster times both functions
+// over the whole sample.
+const timingRounds = 5
+
+// EquivalentFaster proves fast equivalent to slow, then checks that it is
+// at least minSpeedup times quicker.
+//
+// Equivalence is checked as by Equivalent, and nothing is timed if it
+// fails. Both functions are then timed over the same 1024 pre-generated
+// inputs, in five alternating rounds, and the
+// median speedup is compared against minSpeedup. Timing is noisy, so if
+// the median misses the target but the best round reaches it, the shortfall
+// is logged as a warning instead of failing the test.
+//
+// Example:
+//
+//	func TestFactorialTailFaster(t *testing.T) {
+//	    gen := func() int { return rand.Intn(20) + 1 }
+//	    lawtest.EquivalentFaster(t,
+//	        func(n int) int { return Factorial(n) },
+//	        func(n int) int { return FactorialTail(n, 1) },
+//	        gen, 1.5)
+//	}
+//	// ✅ fast is 2.41x faster than slow (required 1.50x)
+//
+// Returns true if the functions are equivalent and fast is not clearly
+// slower than required.
+func EquivalentFaster[T any, R comparable](t TB, slow, fast func(T) R, gen func() T, minSpeedup float64) bool {
+	t.Helper()
+
+	if !Equivalent(t, slow, fast, gen) {
+		return false
+	}
+
+	inputs := make([]T, benchInputs)
+	for i := range inputs {
+		inputs[i] = gen()
+	}
+
+	speedups := make([]float64, timingRounds)
+	for r := range speedups {
+		slowTime, fastTime := timeOver(slow, inputs), timeOver(fast, inputs)
+		speedups[r] = float64(slowTime) / float64(max(fastTime, 1))
+	}
+	sort.Float64s(speedups)
+	median, worst, best := speedups[len(speedups)/2], speedups[0], speedups[len(speedups)-1]
+
+	switch {
+	case median >= minSpeedup:
+		t.Logf("✅ fast is %.2fx faster than slow (required %.2fx)", median, minSpeedup)
+		return true
+	case best >= minSpeedup:
+		t.Logf("⚠ fast is %.2fx faster than slow, short of the required %.2fx but within timing noise (rounds ranged %.2fx to %.2fx)",
+			median, minSpeedup, worst, best)
+		return true
+	default:
+		t.Errorf("Speedup failed: fast is only %.2fx faster than slow, required %.2fx\n  rounds ranged %.2fx to %.2fx over %d inputs",
+			median, minSpeedup, worst, best, len(inputs))
+		return false
+	}
+}
+
+// timeOver returns how long f takes to run over every input.
+func timeOver[T, R any](f func(T) R, inputs []T) time.Duration {
+	var sink R
+	start := time.Now()
+	for _, x := range inputs {
+		sink = f(x)
+	}
+	elapsed := time.Since(start)
+	_ = sink
+	return elapsed
+}
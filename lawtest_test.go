@@ -1,7 +1,13 @@
 package lawtest_test
 
 import (
+	"fmt"
 	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/alexshd/lawtest"
@@ -278,6 +284,46 @@ func TestParallelSafety(t *testing.T) {
 	})
 }
 
+// Test that per-goroutine generators replay from the seed
+func TestParallelAssociativitySeeded(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+
+	// Every generator records what it drew; each is used by one goroutine
+	draws := func(cfg *lawtest.Config) [][]int {
+		var drawn []*[]int
+		newGen := func(r *rand.Rand) lawtest.Generator[int] {
+			own := new([]int)
+			drawn = append(drawn, own)
+			gen := lawtest.IntGenSeeded(-1000, 1000, r)
+			return func() int {
+				v := gen()
+				*own = append(*own, v)
+				return v
+			}
+		}
+		lawtest.TestParallelAssociativitySeededWithConfig(t, add, newGen, 8, cfg)
+
+		values := make([][]int, len(drawn))
+		for i, own := range drawn {
+			values[i] = *own
+		}
+		return values
+	}
+
+	cfg := lawtest.DefaultConfig()
+	cfg.Seed = 99
+	first, second := draws(cfg), draws(cfg)
+	if len(first) != 9 || !reflect.DeepEqual(first, second) {
+		t.Errorf("Expected the same seed to replay every goroutine's inputs, got %v and %v", first, second)
+	}
+	if reflect.DeepEqual(first[1], first[2]) {
+		t.Error("Expected goroutines to draw different inputs")
+	}
+	if reflect.DeepEqual(first[1], first[0][:len(first[1])]) {
+		t.Error("Expected goroutine 0 not to repeat the sequential inputs")
+	}
+}
+
 // Test custom configuration
 func TestWithCustomConfig(t *testing.T) {
 	addOp := func(a, b int) int { return a + b }
@@ -450,6 +496,16 @@ func TestListReverseEquivalence(t *testing.T) {
 	t.Run("Reverse_Equivalence", func(t *testing.T) {
 		lawtest.EquivalentCustom(t, reverseRecursive, reverseIterative, gen, eq)
 	})
+
+	t.Run("Reverse_EquivalenceDeep", func(t *testing.T) {
+		lawtest.EquivalentDeep(t, reverseRecursive, reverseIterative, gen)
+
+		log := &failureLog{}
+		identity := func(list []int) []int { return list }
+		if lawtest.EquivalentDeep(log, reverseIterative, identity, lawtest.SliceGen(lawtest.IntGen(0, 99), 2, 10)) {
+			t.Error("Expected reversing to differ from the identity")
+		}
+	})
 }
 
 // Testing fibonacci implementations
@@ -482,3 +538,448 @@ func TestFibonacciEquivalence(t *testing.T) {
 		lawtest.Equivalent(t, fibRecursive, fibIterative, gen)
 	})
 }
+
+// Testing multi-argument equivalence
+func TestEquivalentN(t *testing.T) {
+	powLoop := func(base, exp int) int {
+		result := 1
+		for i := 0; i < exp; i++ {
+			result *= base
+		}
+		return result
+	}
+	powSquare := func(base, exp int) int {
+		result := 1
+		for ; exp > 0; exp /= 2 {
+			if exp%2 == 1 {
+				result *= base
+			}
+			base *= base
+		}
+		return result
+	}
+	lawtest.Equivalent2(t, powLoop, powSquare, lawtest.IntGen(-5, 5), lawtest.IntGen(0, 10))
+
+	clamp := func(x, lo, hi int) int { return max(lo, min(x, hi)) }
+	clampIf := func(x, lo, hi int) int {
+		if x < lo {
+			return lo
+		}
+		if x > hi {
+			return hi
+		}
+		return x
+	}
+	lawtest.Equivalent3(t, clamp, clampIf, lawtest.IntGen(-100, 100), lawtest.IntGen(-50, 0), lawtest.IntGen(0, 50))
+
+	log := &failureLog{}
+	if lawtest.Equivalent2(log, func(a, b int) int { return a - b }, func(a, b int) int { return b - a }, lawtest.IntGen(1, 10), lawtest.IntGen(11, 20)) {
+		t.Error("Expected a - b and b - a to differ")
+	}
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "f1(a, b)=") {
+		t.Errorf("Expected one failure naming both arguments, got %q", log.errors)
+	}
+}
+
+// Semigroup that counts how often it is exercised
+type CountingSemigroup struct {
+	ops, gens *int
+}
+
+func (s CountingSemigroup) Op(a, b int) int {
+	*s.ops++
+	return a + b
+}
+
+func (s CountingSemigroup) Gen() int {
+	*s.gens++
+	return rand.Intn(100)
+}
+
+// Test that Closure runs cfg.TestCases iterations
+func TestClosureHonorsConfig(t *testing.T) {
+	t.Run("ClosureWithConfig", func(t *testing.T) {
+		calls := 0
+		op := func(a, b int) int {
+			calls++
+			return a + b
+		}
+
+		cfg := lawtest.DefaultConfig()
+		cfg.TestCases = 37
+		lawtest.ClosureWithConfig(t, op, lawtest.IntGen(0, 10), cfg)
+
+		if calls != 37 {
+			t.Errorf("Expected 37 closure iterations, got %d", calls)
+		}
+	})
+
+	t.Run("TestSemigroupWithConfig", func(t *testing.T) {
+		var ops, gens int
+		s := CountingSemigroup{ops: &ops, gens: &gens}
+
+		cfg := lawtest.DefaultConfig()
+		cfg.TestCases = 50
+		lawtest.TestSemigroupWithConfig[int](t, s, cfg)
+
+		// Associativity: 3 gens and 4 ops per case; Closure: 2 gens and 1 op per case
+		if gens != 5*50 || ops != 5*50 {
+			t.Errorf("Expected 250 gens and 250 ops, got %d gens and %d ops", gens, ops)
+		}
+	})
+}
+
+// Test deep immutability checks for pointer types
+func TestImmutableOpDeep(t *testing.T) {
+	type Counter struct {
+		counts map[string]int
+	}
+
+	merge := func(a, b *Counter) *Counter {
+		result := &Counter{counts: map[string]int{}}
+		for k, v := range a.counts {
+			result.counts[k] += v
+		}
+		for k, v := range b.counts {
+			result.counts[k] += v
+		}
+		return result
+	}
+	gen := func() *Counter {
+		return &Counter{counts: map[string]int{lawtest.StringGen(1)(): rand.Intn(10)}}
+	}
+	clone := func(c *Counter) *Counter {
+		counts := make(map[string]int, len(c.counts))
+		for k, v := range c.counts {
+			counts[k] = v
+		}
+		return &Counter{counts: counts}
+	}
+	eq := func(a, b *Counter) bool { return reflect.DeepEqual(a.counts, b.counts) }
+
+	lawtest.ImmutableOpDeep(t, merge, gen, clone, eq)
+}
+
+// Test the Check functions outside of a failing *testing.T
+func TestCheckResults(t *testing.T) {
+	cfg := lawtest.DefaultConfig()
+	gen := lawtest.IntGen(-100, 100)
+
+	res := lawtest.CheckAssociative(func(a, b int) int { return a + b }, gen, cfg)
+	if !res.Passed || res.CasesRun != cfg.TestCases || res.Counterexample != nil || res.Message != "" {
+		t.Errorf("Expected addition to pass, got %+v", res)
+	}
+
+	res = lawtest.CheckAssociative(func(a, b int) int { return a - b }, gen, cfg)
+	if res.Passed || len(res.Counterexample) != 3 || res.Message == "" || res.Seed == 0 {
+		t.Errorf("Expected subtraction to fail with a counterexample, got %+v", res)
+	}
+
+	// With a shrinker the counterexample is minimal: (a-b)-c != a-(b-c)
+	// whenever c != 0, so a=0, b=0, c=1 is the simplest failure.
+	shrinking := lawtest.DefaultConfig()
+	shrinking.Shrinker = lawtest.Shrinker[int](lawtest.ShrinkInt)
+	res = lawtest.CheckAssociative(func(a, b int) int { return a - b }, gen, shrinking)
+	if got := res.Counterexample; len(got) != 3 || got[0] != 0 || got[1] != 0 || (got[2] != 1 && got[2] != -1) {
+		t.Errorf("Expected minimal counterexample [0 0 ±1], got %v", got)
+	}
+
+	if res := lawtest.CheckCommutative(func(a, b int) int { return a * b }, gen, cfg); !res.Passed {
+		t.Errorf("Expected multiplication to commute: %s", res.Message)
+	}
+	if res := lawtest.CheckIdentity(func(a, b int) int { return a * b }, 0, gen, cfg); res.Passed {
+		t.Error("Expected 0 to fail as the multiplicative identity")
+	}
+	if res := lawtest.CheckInverse(func(a, b int) int { return a + b }, func(a int) int { return -a }, 0, gen, cfg); !res.Passed {
+		t.Errorf("Expected negation to invert addition: %s", res.Message)
+	}
+	if res := lawtest.CheckIdempotent(func(a int) int { return a + 1 }, gen, cfg); res.Passed || len(res.Counterexample) != 1 {
+		t.Errorf("Expected increment to fail idempotence with one input, got %+v", res)
+	}
+}
+
+// failureLog is a lawtest.TB that records failures instead of failing the test
+type failureLog struct {
+	errors []string
+}
+
+func (l *failureLog) Helper()                         {}
+func (l *failureLog) Logf(format string, args ...any) {}
+func (l *failureLog) Errorf(format string, args ...any) {
+	l.errors = append(l.errors, fmt.Sprintf(format, args...))
+}
+
+// Test that properties report through any TB
+func TestPropertiesAcceptTB(t *testing.T) {
+	log := &failureLog{}
+	sub := func(a, b int) int { return a - b }
+	lawtest.Associative(log, sub, lawtest.IntGen(-100, 100))
+
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Associativity failed") {
+		t.Errorf("Expected one recorded associativity failure, got %q", log.errors)
+	}
+
+	// A group whose inverse is wrong
+	lawtest.ExpectGroupFailure(t, BrokenInverseGroup{}, "inverse")
+}
+
+// BrokenInverseGroup is integer addition with an incorrect inverse
+type BrokenInverseGroup struct{ IntAdditionGroup }
+
+func (g BrokenInverseGroup) Inverse(a int) int { return a }
+
+// Test that CheckGroup reports every law separately
+func TestCheckGroup(t *testing.T) {
+	if report := lawtest.CheckGroup[int](IntAdditionGroup{}, lawtest.DefaultConfig()); !report.Passed() {
+		t.Errorf("Expected integer addition to be a group, got %+v", report)
+	}
+
+	report := lawtest.CheckGroup[int](BrokenInverseGroup{}, lawtest.DefaultConfig())
+	if report.Passed() || report.Inverse.Passed || len(report.Inverse.Counterexample) != 1 {
+		t.Errorf("Expected the inverse law to fail with a counterexample, got %+v", report.Inverse)
+	}
+	if !report.Associativity.Passed || !report.Identity.Passed || !report.Closure.Passed {
+		t.Errorf("Expected the other laws to hold, got %+v", report)
+	}
+}
+
+// Benchmark that properties can be checked under *testing.B
+func BenchmarkAssociative(b *testing.B) {
+	add := func(a, b int) int { return a + b }
+	gen := lawtest.IntGen(-100, 100)
+	for i := 0; i < b.N; i++ {
+		lawtest.Associative(b, add, gen)
+	}
+}
+
+// Test that BenchEquivalent benchmarks equivalent functions and refuses others
+func TestBenchEquivalent(t *testing.T) {
+	sumLoop := func(n int) int {
+		s := 0
+		for i := 1; i <= n; i++ {
+			s += i
+		}
+		return s
+	}
+	sumFormula := func(n int) int { return n * (n + 1) / 2 }
+	gen := lawtest.IntGen(0, 100)
+
+	res := testing.Benchmark(func(b *testing.B) {
+		lawtest.BenchEquivalent(b, sumLoop, func(n int) int { return n * n }, gen)
+	})
+	if res.N != 0 {
+		t.Errorf("Expected non-equivalent functions to stop the benchmark, got %d iterations", res.N)
+	}
+
+	if testing.Short() {
+		t.Skip("skipping full benchmark run in short mode")
+	}
+
+	res = testing.Benchmark(func(b *testing.B) {
+		lawtest.BenchEquivalent(b, sumLoop, sumFormula, gen)
+	})
+	if res.N == 0 {
+		t.Error("Expected equivalent functions to be benchmarked")
+	}
+}
+
+// Test that EquivalentFaster requires the speedup as well as equivalence
+func TestEquivalentFaster(t *testing.T) {
+	fibRecursive := func(n int) int {
+		var fib func(int) int
+		fib = func(n int) int {
+			if n < 2 {
+				return n
+			}
+			return fib(n-1) + fib(n-2)
+		}
+		return fib(n)
+	}
+	fibIterative := func(n int) int {
+		a, b := 0, 1
+		for i := 0; i < n; i++ {
+			a, b = b, a+b
+		}
+		return a
+	}
+	gen := lawtest.IntGen(12, 16)
+
+	if !lawtest.EquivalentFaster(t, fibRecursive, fibIterative, gen, 2) {
+		t.Error("Expected iterative Fibonacci to be at least twice as fast")
+	}
+
+	log := &failureLog{}
+	if lawtest.EquivalentFaster(log, fibIterative, fibRecursive, gen, 2) {
+		t.Error("Expected recursive Fibonacci not to be faster than iterative")
+	}
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Speedup failed") {
+		t.Errorf("Expected a speedup failure, got %q", log.errors)
+	}
+
+	log = &failureLog{}
+	if lawtest.EquivalentFaster(log, fibIterative, func(n int) int { return n }, gen, 1) {
+		t.Error("Expected non-equivalent functions to fail before timing")
+	}
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "not equivalent") {
+		t.Errorf("Expected an equivalence failure, got %q", log.errors)
+	}
+}
+
+// Test that RequireDistinct redraws equal operands and falls back when the
+// generator can't produce distinct values
+func TestRequireDistinct(t *testing.T) {
+	cfg := lawtest.DefaultConfig()
+	cfg.RequireDistinct = true
+
+	equal := 0
+	first := func(a, b int) int {
+		if a == b {
+			equal++
+		}
+		return a
+	}
+	if res := lawtest.CheckCommutative(first, lawtest.IntGen(0, 3), cfg); res.Passed {
+		t.Error("Expected first-argument projection to fail commutativity")
+	}
+	if equal != 0 {
+		t.Errorf("Expected only distinct operands, saw %d equal pairs", equal)
+	}
+
+	add := func(a, b int) int { return a + b }
+	if res := lawtest.CheckCommutative(add, lawtest.IntGen(5, 5), cfg); !res.Passed || res.CasesRun != cfg.TestCases {
+		t.Errorf("Expected a constant generator to fall back and pass, got %+v", res)
+	}
+	if res := lawtest.CheckAssociative(add, lawtest.IntGen(0, 1), cfg); !res.Passed {
+		t.Errorf("Expected two values to fall back for three operands: %s", res.Message)
+	}
+}
+
+// Test that SelfOpProbability repeats operands to expose self-merge bugs
+func TestSelfOpProbability(t *testing.T) {
+	// Adds, except that merging a value with itself is off by one
+	selfBug := func(a, b int) int {
+		if a == b {
+			return 2*a + 1
+		}
+		return a + b
+	}
+	gen := lawtest.IntGen(-1000000, 1000000)
+
+	cfg := lawtest.DefaultConfig()
+	cfg.Seed = 7
+	if res := lawtest.CheckAssociative(selfBug, gen, cfg); !res.Passed {
+		t.Fatalf("Expected the bug to go unnoticed without repeated operands: %s", res.Message)
+	}
+
+	cfg.SelfOpProbability = 0.5
+	res := lawtest.CheckAssociative(selfBug, gen, cfg)
+	if res.Passed || (res.Counterexample[0] != res.Counterexample[1] && res.Counterexample[1] != res.Counterexample[2]) {
+		t.Errorf("Expected a failure with a repeated operand, got %+v", res)
+	}
+
+	equal := 0
+	countEqual := func(a, b int) int {
+		if a == b {
+			equal++
+		}
+		return a + b
+	}
+	cfg.SelfOpProbability = 1
+	lawtest.CheckCommutative(countEqual, gen, cfg)
+	if equal != 2*cfg.TestCases {
+		t.Errorf("Expected every pair to repeat with probability 1, saw %d equal pairs", equal)
+	}
+
+	cfg.RequireDistinct = true
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected RequireDistinct with SelfOpProbability to panic")
+		}
+	}()
+	lawtest.CheckCommutative(countEqual, gen, cfg)
+}
+
+// counter is a pointer type used to test detection of shared mutation
+type counter struct{ n int }
+
+// Test that ParallelSafe compares pointer results by value, and that
+// ParallelSafeDeep catches operations that mutate their shared inputs
+func TestParallelSafeDetectsMutation(t *testing.T) {
+	gen := func() *counter { return &counter{n: lawtest.IntGen(1, 100)()} }
+
+	pure := func(a, b *counter) *counter { return &counter{n: a.n + b.n} }
+	log := &logRecorder{}
+	if !lawtest.ParallelSafe(log, pure, gen, 10) {
+		t.Error("Expected a fresh-result merge to be parallel-safe")
+	}
+	if !slices.ContainsFunc(log.logs, func(l string) bool { return strings.Contains(l, "use ParallelSafeDeep") }) {
+		t.Errorf("Expected the skipped mutation check to be logged, got %q", log.logs)
+	}
+
+	// Mutates the receiver like BrokenCache.Merge in the examples. The
+	// mutex keeps the test itself clean under -race.
+	var mu sync.Mutex
+	mutating := func(a, b *counter) *counter {
+		mu.Lock()
+		defer mu.Unlock()
+		a.n += b.n
+		return a
+	}
+	clone := func(c *counter) *counter { return &counter{n: c.n} }
+	eq := func(a, b *counter) bool { return a.n == b.n }
+	if lawtest.ParallelSafeDeep(&failureLog{}, mutating, gen, clone, eq, 10) {
+		t.Error("Expected a mutating merge to be flagged")
+	}
+
+	// The reference is built from clones, so any generator works, even one
+	// that can't be replayed and is used up after two draws per case
+	cfg := lawtest.DefaultConfig()
+	values := make([]*counter, 2*cfg.TestCases)
+	for i := range values {
+		values[i] = &counter{n: rand.Intn(100) + 1}
+	}
+	if !lawtest.ParallelSafeDeepWithConfig(t, pure, lawtest.FromSliceOnce(values), clone, eq, 10, cfg) {
+		t.Error("Expected a fresh-result merge to be parallel-safe")
+	}
+
+	failures := &failureLog{}
+	addInto := func(a, b []int) []int {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range a {
+			a[i] += b[i]
+		}
+		return a
+	}
+	sliceGen := lawtest.SliceGen(lawtest.IntGen(0, 9), 3, 3)
+	if lawtest.ParallelSafeDeep(failures, addInto, sliceGen, slices.Clone[[]int], slices.Equal[[]int], 10) || len(failures.errors) != 1 {
+		t.Errorf("Expected one reported failure for in-place addition, got %q", failures.errors)
+	}
+
+	// Value types are copied, so ParallelSafe needs no clone func for them
+	type pair struct{ a, b int }
+	log = &logRecorder{}
+	addPairs := func(x, y pair) pair { return pair{x.a + y.a, x.b + y.b} }
+	if !lawtest.ParallelSafe(log, addPairs, func() pair { return pair{1, 2} }, 10) {
+		t.Error("Expected a value-type merge to be parallel-safe")
+	}
+	if slices.ContainsFunc(log.logs, func(l string) bool { return strings.Contains(l, "use ParallelSafeDeep") }) {
+		t.Errorf("Expected value types to be checked in full, got %q", log.logs)
+	}
+}
+
+// Test that DeterministicUnderConcurrency tallies rounds with diverging results
+func TestDeterministicUnderConcurrency(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	lawtest.DeterministicUnderConcurrency(t, add, lawtest.IntGen(-100, 100), 10, 20)
+
+	// A shared counter leaks into the result without any data race
+	var calls atomic.Int64
+	leaky := func(a, b int) int { return a + b + int(calls.Add(1)%2) }
+
+	log := &failureLog{}
+	lawtest.DeterministicUnderConcurrency(log, leaky, lawtest.IntGen(-100, 100), 10, 20)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "20 of 20 rounds diverged") {
+		t.Errorf("Expected every round to diverge, got %q", log.errors)
+	}
+}
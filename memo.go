@@ -0,0 +1,155 @@
+package lawtest
+
+import (
+	"sync"
+	"time"
+)
+
+// ===========================================================================
+// MEMOIZATION
+// ===========================================================================
+
+// TestMemoized verifies that a memoized function returns what the pure
+// function it caches returns, and keeps returning it: memoized(x) = pure(x)
+// on every one of repeat calls with the same x.
+//
+// The first call fills the cache and the later ones read from it, so a
+// cache keyed on the wrong value, or one that stores a result before it is
+// final, shows up as a repeated call disagreeing with the first. Repeat
+// defaults to 2 if smaller. The average duration of first and repeated
+// calls is logged, to confirm the cache is actually being hit; timing is
+// too noisy to fail on.
+//
+// Example:
+//
+//	func TestFibMemoized(t *testing.T) {
+//	    memo := NewFibCache()
+//	    lawtest.TestMemoized(t, Fibonacci, memo.Fib, lawtest.IntGen(0, 25), 3)
+//	}
+//	// lawtest: first calls averaged 1.2ms, repeated calls 85ns
+func TestMemoized[T comparable, R comparable](t TB, pure func(T) R, memoized func(T) R, gen Generator[T], repeat int) {
+	TestMemoizedWithConfig(t, pure, memoized, gen, repeat, DefaultConfig())
+}
+
+// TestMemoizedWithConfig verifies a memoized function with custom configuration.
+func TestMemoizedWithConfig[T comparable, R comparable](t TB, pure func(T) R, memoized func(T) R, gen Generator[T], repeat int, cfg *Config) {
+	t.Helper()
+
+	if repeat < 2 {
+		repeat = 2 // A single call can't show the cached result
+	}
+
+	var first, repeated time.Duration
+	if !checkCases(t, cfg, func(int) string {
+		x := gen()
+		want := pure(x)
+
+		var initial R
+		for call := 1; call <= repeat; call++ {
+			start := time.Now()
+			got := memoized(x)
+			if elapsed := time.Since(start); call == 1 {
+				first += elapsed
+				initial = got
+			} else {
+				repeated += elapsed
+			}
+
+			if got != want && call == 1 {
+				return cfg.sprintf("Memoization failed: memoized(x) != pure(x)\n  x=%v\n  pure(x)=%v, memoized(x)=%v",
+					x, want, got)
+			}
+			if got != want {
+				return cfg.sprintf("Memoization failed: call %d of memoized(x) != pure(x)\n  x=%v\n  pure(x)=%v, first call=%v, call %d=%v",
+					call, x, want, initial, call, got)
+			}
+		}
+
+		return ""
+	}) || cfg.TestCases == 0 {
+		return
+	}
+
+	t.Logf("lawtest: first calls averaged %v, repeated calls %v",
+		first/time.Duration(cfg.TestCases), repeated/time.Duration(cfg.TestCases*(repeat-1)))
+}
+
+// memoKeys is how many keys each round of TestMemoizedConcurrent shares
+// between its goroutines.
+const memoKeys = 4
+
+// TestMemoizedConcurrent verifies that a memoized function is safe to call
+// from many goroutines at once: every goroutine gets the same result for
+// the same input.
+//
+// Each case draws a handful of keys and releases the goroutines together,
+// each calling memoized on every key in a different order, so that cache
+// misses, fills and hits for the same key overlap. A memoizer that writes a
+// plain map without a lock is the classic bug here; run the test with -race
+// to have it reported as a data race rather than an occasional
+// "concurrent map writes" crash. Goroutines defaults to 10 if less than 2.
+//
+// Example:
+//
+//	func TestFibCacheConcurrent(t *testing.T) {
+//	    memo := NewFibCache()
+//	    lawtest.TestMemoizedConcurrent(t, memo.Fib, lawtest.IntGen(0, 25), 8)
+//	}
+func TestMemoizedConcurrent[T comparable, R comparable](t TB, memoized func(T) R, gen Generator[T], goroutines int) {
+	TestMemoizedConcurrentWithConfig(t, memoized, gen, goroutines, DefaultConfig())
+}
+
+// TestMemoizedConcurrentWithConfig verifies concurrent memoization with custom configuration.
+func TestMemoizedConcurrentWithConfig[T comparable, R comparable](t TB, memoized func(T) R, gen Generator[T], goroutines int, cfg *Config) {
+	t.Helper()
+
+	if goroutines < 2 {
+		goroutines = 10 // Default to 10 goroutines
+	}
+
+	checkCases(t, cfg, func(int) string {
+		keys := make([]T, memoKeys)
+		for k := range keys {
+			keys[k] = gen()
+		}
+
+		results := make([][]R, goroutines)
+		panics := make([]any, goroutines)
+		start := make(chan struct{})
+
+		var wg sync.WaitGroup
+		for g := 0; g < goroutines; g++ {
+			wg.Add(1)
+			go func(id int) {
+				defer wg.Done()
+				defer func() { panics[id] = recover() }()
+
+				<-start
+				results[id] = make([]R, len(keys))
+				for j := range keys {
+					k := (j + id) % len(keys) // Each goroutine starts at a different key
+					results[id][k] = memoized(keys[k])
+				}
+			}(g)
+		}
+		close(start)
+		wg.Wait()
+
+		for g := range results {
+			if panics[g] != nil {
+				return cfg.sprintf("Concurrent memoization failed: goroutine %d panicked: %v\n  keys=%v",
+					g, panics[g], keys)
+			}
+		}
+		for g := 1; g < goroutines; g++ {
+			for k, x := range keys {
+				if results[g][k] != results[0][k] {
+					return cfg.sprintf("Concurrent memoization failed: goroutines disagree on memoized(x)\n  x=%v\n  goroutine 0 got=%v, goroutine %d got=%v",
+						x, results[0][k], g, results[g][k])
+				}
+			}
+		}
+
+		return ""
+	})
+}
@@ -0,0 +1,101 @@
+package lawtest_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// fib is the naive recursive Fibonacci used as a memoization reference
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+// Test that a correct cache agrees with the pure function on repeated calls
+func TestMemoized(t *testing.T) {
+	cache := map[int]int{}
+	var memoFib func(int) int
+	memoFib = func(n int) int {
+		if v, ok := cache[n]; ok {
+			return v
+		}
+		v := n
+		if n >= 2 {
+			v = memoFib(n-1) + memoFib(n-2)
+		}
+		cache[n] = v
+		return v
+	}
+
+	lawtest.TestMemoized(t, fib, memoFib, lawtest.IntGen(0, 20), 3)
+
+	cfg := lawtest.DefaultConfig()
+	cfg.TestCases = 20
+	lawtest.TestMemoizedWithConfig(t, fib, memoFib, lawtest.IntGen(0, 20), 0, cfg)
+}
+
+// Test that caches returning the wrong value are reported
+func TestMemoizedFailures(t *testing.T) {
+	// Keyed on n/2, so n and n+1 share an entry
+	byHalf := map[int]int{}
+	wrongKey := func(n int) int {
+		if v, ok := byHalf[n/2]; ok {
+			return v
+		}
+		byHalf[n/2] = fib(n)
+		return byHalf[n/2]
+	}
+
+	log := &failureLog{}
+	lawtest.TestMemoized(log, fib, wrongKey, lawtest.IntGen(2, 20), 2)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Memoization failed") {
+		t.Errorf("Expected the wrong key to be reported, got %q", log.errors)
+	}
+
+	// Stores a placeholder that later calls return instead of the result
+	placeholders := map[int]int{}
+	stale := func(n int) int {
+		if v, ok := placeholders[n]; ok {
+			return v
+		}
+		placeholders[n] = -1
+		return fib(n)
+	}
+
+	log = &failureLog{}
+	lawtest.TestMemoized(log, fib, stale, lawtest.IntGen(0, 20), 3)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "call 2 of memoized(x) != pure(x)") {
+		t.Errorf("Expected the stale entry to be reported on the second call, got %q", log.errors)
+	}
+
+	// No cases means nothing to time
+	cfg := lawtest.DefaultConfig()
+	cfg.TestCases = 0
+	lawtest.TestMemoizedWithConfig(t, fib, stale, lawtest.IntGen(0, 20), 2, cfg)
+}
+
+// Test that a locked cache gives every goroutine the same results
+func TestMemoizedConcurrent(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		cache = map[int]int{}
+	)
+	memoFib := func(n int) int {
+		mu.Lock()
+		defer mu.Unlock()
+		if v, ok := cache[n]; ok {
+			return v
+		}
+		v := fib(n)
+		cache[n] = v
+		return v
+	}
+
+	lawtest.TestMemoizedConcurrent(t, memoFib, lawtest.IntGen(0, 20), 8)
+	lawtest.TestMemoizedConcurrentWithConfig(t, memoFib, lawtest.IntGen(0, 20), 0, lawtest.DefaultConfig())
+}
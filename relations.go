@@ -0,0 +1,355 @@
+package lawtest
+
+import "testing"
+
+// ===========================================================================
+// EQUIVALENCE RELATIONS
+// ===========================================================================
+
+// Relation is a binary relation between two values of type T.
+//
+// Example:
+//
+//	sameParity := func(a, b int) bool { return a%2 == b%2 }
+//	caseInsensitive := func(a, b string) bool { return strings.EqualFold(a, b) }
+type Relation[T any] func(a, b T) bool
+
+// Reflexive tests if every value is related to itself: rel(a, a).
+//
+// Example:
+//
+//	func TestEqualFoldReflexive(t *testing.T) {
+//	    rel := func(a, b string) bool { return strings.EqualFold(a, b) }
+//	    lawtest.Reflexive(t, rel, lawtest.StringGen(5))
+//	}
+func Reflexive[T any](t TB, rel Relation[T], gen Generator[T]) {
+	ReflexiveWithConfig(t, rel, gen, DefaultConfig())
+}
+
+// ReflexiveWithConfig tests reflexivity with custom configuration.
+func ReflexiveWithConfig[T any](t TB, rel Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		if !rel(a, a) {
+			return cfg.sprintf("Reflexivity failed: rel(a, a) is false\n  a=%v", a)
+		}
+
+		return ""
+	})
+}
+
+// Symmetric tests if a relation holds in both directions: rel(a, b) = rel(b, a).
+//
+// Example:
+//
+//	func TestSameParitySymmetric(t *testing.T) {
+//	    rel := func(a, b int) bool { return a%2 == b%2 }
+//	    lawtest.Symmetric(t, rel, lawtest.IntGen(-100, 100))
+//	}
+func Symmetric[T any](t TB, rel Relation[T], gen Generator[T]) {
+	SymmetricWithConfig(t, rel, gen, DefaultConfig())
+}
+
+// SymmetricWithConfig tests symmetry with custom configuration.
+func SymmetricWithConfig[T any](t TB, rel Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		a, b := gen(), gen()
+
+		ab := rel(a, b)
+		ba := rel(b, a)
+
+		if ab != ba {
+			return cfg.sprintf("Symmetry failed: rel(a, b) != rel(b, a)\n  a=%v, b=%v\n  rel(a, b)=%v, rel(b, a)=%v",
+				a, b, ab, ba)
+		}
+
+		return ""
+	})
+}
+
+// Transitive tests if a relation chains: rel(a, b) and rel(b, c) imply rel(a, c).
+//
+// Random triples rarely form a chain, so for each a the test searches the
+// generator for a b related to a, then for a c related to b. Cases where no
+// chain is found are skipped. When related values are too rare for the
+// search to find, use TransitiveSeeds with a hand-picked cluster instead.
+//
+// A broken chain is shrunk with Config.Shrinker to a simpler one that is
+// still broken.
+//
+// Example:
+//
+//	func TestSameParityTransitive(t *testing.T) {
+//	    rel := func(a, b int) bool { return a%2 == b%2 }
+//	    lawtest.Transitive(t, rel, lawtest.IntGen(-100, 100))
+//	}
+func Transitive[T any](t TB, rel Relation[T], gen Generator[T]) {
+	TransitiveWithConfig(t, rel, gen, DefaultConfig())
+}
+
+// TransitiveWithConfig tests transitivity with custom configuration.
+func TransitiveWithConfig[T any](t TB, rel Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	chains := 0
+	ok := checkCases(t, cfg, func(int) string {
+		a := gen()
+
+		b, ok := searchRelated(rel, a, gen)
+		if !ok {
+			return ""
+		}
+
+		c, ok := searchRelated(rel, b, gen)
+		if !ok {
+			return ""
+		}
+
+		chains++
+		msg, _ := shrinkFailure(cfg, []T{a, b, c}, func(v []T) string {
+			a, b, c := v[0], v[1], v[2]
+			if rel(a, b) && rel(b, c) && !rel(a, c) {
+				return cfg.sprintf("Transitivity failed: rel(a, b) and rel(b, c) but not rel(a, c)\n  a=%v, b=%v, c=%v",
+					a, b, c)
+			}
+			return ""
+		})
+		return msg
+	})
+
+	if ok && chains == 0 {
+		t.Logf("⚠ No related chains found in %d cases; consider TransitiveSeeds", cfg.TestCases)
+	}
+}
+
+// TransitiveSeeds tests transitivity exhaustively over every triple drawn
+// from seeds.
+//
+// Use this when related values are too rare for random search, by passing
+// a cluster of elements you expect to be related.
+//
+// Example:
+//
+//	rel := func(a, b float64) bool { return math.Abs(a-b) < 0.1 }
+//	lawtest.TransitiveSeeds(t, rel, []float64{1.0, 1.05, 1.1}) // fails: not transitive
+func TransitiveSeeds[T any](t TB, rel Relation[T], seeds []T) {
+	t.Helper()
+
+	for _, a := range seeds {
+		for _, b := range seeds {
+			if !rel(a, b) {
+				continue
+			}
+			for _, c := range seeds {
+				if rel(b, c) && !rel(a, c) {
+					t.Errorf("Transitivity failed: rel(a, b) and rel(b, c) but not rel(a, c)\n  a=%v, b=%v, c=%v",
+						a, b, c)
+					return
+				}
+			}
+		}
+	}
+}
+
+// TestEquivalenceRelation verifies that a relation is an equivalence relation.
+//
+// Tests performed:
+//   - Reflexivity: rel(a, a)
+//   - Symmetry: rel(a, b) = rel(b, a)
+//   - Transitivity: rel(a, b) and rel(b, c) imply rel(a, c)
+//
+// Example:
+//
+//	func TestCustomEquality(t *testing.T) {
+//	    eq := func(a, b User) bool { return a.ID == b.ID }
+//	    lawtest.TestEquivalenceRelation(t, eq, UserGen())
+//	}
+func TestEquivalenceRelation[T any](t *testing.T, rel Relation[T], gen Generator[T]) {
+	TestEquivalenceRelationWithConfig(t, rel, gen, DefaultConfig())
+}
+
+// TestEquivalenceRelationWithConfig verifies an equivalence relation with custom configuration.
+func TestEquivalenceRelationWithConfig[T any](t *testing.T, rel Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	t.Run("Reflexivity", func(t *testing.T) {
+		ReflexiveWithConfig(t, rel, gen, cfg)
+	})
+
+	t.Run("Symmetry", func(t *testing.T) {
+		SymmetricWithConfig(t, rel, gen, cfg)
+	})
+
+	t.Run("Transitivity", func(t *testing.T) {
+		TransitiveWithConfig(t, rel, gen, cfg)
+	})
+}
+
+// searchRelated draws candidates from gen until one is related to a.
+func searchRelated[T any](rel Relation[T], a T, gen Generator[T]) (T, bool) {
+	for j := 0; j < searchCandidates; j++ {
+		b := gen()
+		if rel(a, b) {
+			return b, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// ===========================================================================
+// ORDER RELATIONS
+// ===========================================================================
+
+// Irreflexive tests if no value is related to itself: not rel(a, a).
+//
+// Strict orders such as < must be irreflexive.
+func Irreflexive[T any](t TB, rel Relation[T], gen Generator[T]) {
+	IrreflexiveWithConfig(t, rel, gen, DefaultConfig())
+}
+
+// IrreflexiveWithConfig tests irreflexivity with custom configuration.
+func IrreflexiveWithConfig[T any](t TB, rel Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		msg, _ := shrinkFailure(cfg, []T{gen()}, func(v []T) string {
+			a := v[0]
+			if rel(a, a) {
+				return cfg.sprintf("Irreflexivity failed: rel(a, a) is true\n  a=%v", a)
+			}
+			return ""
+		})
+		return msg
+	})
+}
+
+// Asymmetric tests if a relation never holds in both directions:
+// rel(a, b) implies not rel(b, a).
+//
+// This catches the classic comparator bug of returning true for both orders.
+func Asymmetric[T any](t TB, rel Relation[T], gen Generator[T]) {
+	AsymmetricWithConfig(t, rel, gen, DefaultConfig())
+}
+
+// AsymmetricWithConfig tests asymmetry with custom configuration.
+func AsymmetricWithConfig[T any](t TB, rel Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		msg, _ := shrinkFailure(cfg, []T{gen(), gen()}, func(v []T) string {
+			a, b := v[0], v[1]
+			if rel(a, b) && rel(b, a) {
+				return cfg.sprintf("Asymmetry failed: rel(a, b) and rel(b, a) are both true\n  a=%v, b=%v", a, b)
+			}
+			return ""
+		})
+		return msg
+	})
+}
+
+// Total tests if every pair of distinct values is related in exactly one
+// direction: a != b implies exactly one of rel(a, b) and rel(b, a).
+func Total[T comparable](t TB, rel Relation[T], gen Generator[T]) {
+	TotalWithConfig(t, rel, gen, DefaultConfig())
+}
+
+// TotalWithConfig tests totality with custom configuration.
+func TotalWithConfig[T comparable](t TB, rel Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	checkCases(t, cfg, func(int) string {
+		msg, _ := shrinkFailure(cfg, []T{gen(), gen()}, func(v []T) string {
+			a, b := v[0], v[1]
+			if a == b {
+				return ""
+			}
+
+			ab := rel(a, b)
+			ba := rel(b, a)
+
+			if ab == ba {
+				return cfg.sprintf("Totality failed: distinct values must be related in exactly one direction\n  a=%v, b=%v\n  rel(a, b)=%v, rel(b, a)=%v",
+					a, b, ab, ba)
+			}
+			return ""
+		})
+		return msg
+	})
+}
+
+// TestPartialOrder verifies that less is a strict partial order.
+//
+// Tests performed:
+//   - Irreflexivity: not less(a, a)
+//   - Asymmetry: less(a, b) implies not less(b, a)
+//   - Transitivity: less(a, b) and less(b, c) imply less(a, c)
+//
+// With Config.Shrinker set, a failure reports the simplest offending tuple
+// the shrinker can find, along with the original one.
+//
+// Example:
+//
+//	func TestSubsetOrder(t *testing.T) {
+//	    properSubset := func(a, b uint8) bool { return a != b && a&b == a }
+//	    lawtest.TestPartialOrder(t, properSubset, BitmaskGen())
+//	}
+func TestPartialOrder[T any](t *testing.T, less Relation[T], gen Generator[T]) {
+	TestPartialOrderWithConfig(t, less, gen, DefaultConfig())
+}
+
+// TestPartialOrderWithConfig verifies a strict partial order with custom configuration.
+func TestPartialOrderWithConfig[T any](t *testing.T, less Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	t.Run("Irreflexivity", func(t *testing.T) {
+		IrreflexiveWithConfig(t, less, gen, cfg)
+	})
+
+	t.Run("Asymmetry", func(t *testing.T) {
+		AsymmetricWithConfig(t, less, gen, cfg)
+	})
+
+	t.Run("Transitivity", func(t *testing.T) {
+		TransitiveWithConfig(t, less, gen, cfg)
+	})
+}
+
+// TestTotalOrder verifies that less is a strict total order, as required
+// by sort.Interface comparators.
+//
+// Tests performed:
+//   - All partial order properties (see TestPartialOrder)
+//   - Totality: for a != b, exactly one of less(a, b) and less(b, a) holds
+//
+// Example:
+//
+//	func TestByAgeComparator(t *testing.T) {
+//	    less := func(a, b Person) bool {
+//	        if a.Age != b.Age {
+//	            return a.Age < b.Age
+//	        }
+//	        return a.Name < b.Name
+//	    }
+//	    lawtest.TestTotalOrder(t, less, PersonGen())
+//	}
+func TestTotalOrder[T comparable](t *testing.T, less Relation[T], gen Generator[T]) {
+	TestTotalOrderWithConfig(t, less, gen, DefaultConfig())
+}
+
+// TestTotalOrderWithConfig verifies a strict total order with custom configuration.
+func TestTotalOrderWithConfig[T comparable](t *testing.T, less Relation[T], gen Generator[T], cfg *Config) {
+	t.Helper()
+
+	TestPartialOrderWithConfig(t, less, gen, cfg)
+
+	t.Run("Totality", func(t *testing.T) {
+		TotalWithConfig(t, less, gen, cfg)
+	})
+}
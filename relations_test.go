@@ -0,0 +1,106 @@
+package lawtest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Testing equivalence relations
+func TestEquivalenceRelations(t *testing.T) {
+	t.Run("SameParity", func(t *testing.T) {
+		sameParity := func(a, b int) bool { return (a-b)%2 == 0 }
+		lawtest.TestEquivalenceRelation(t, sameParity, lawtest.IntGen(-100, 100))
+	})
+
+	t.Run("EqualFold", func(t *testing.T) {
+		lawtest.TestEquivalenceRelation(t, strings.EqualFold, lawtest.StringGen(1))
+	})
+
+	t.Run("TransitiveSeeds", func(t *testing.T) {
+		sameMod3 := func(a, b int) bool { return (a-b)%3 == 0 }
+		lawtest.TransitiveSeeds(t, sameMod3, []int{0, 3, 6, 1, 4, 7})
+	})
+}
+
+// Testing that broken equivalence relations are caught
+func TestEquivalenceFailures(t *testing.T) {
+	// <= holds one way only for distinct values
+	lessEq := func(a, b int) bool { return a <= b }
+	log := &failureLog{}
+	lawtest.Symmetric(log, lessEq, lawtest.IntGen(-100, 100))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Symmetry failed") {
+		t.Errorf("Expected <= to fail symmetry, got %q", log.errors)
+	}
+
+	// Being within one of each other doesn't chain: 0 ~ 1 ~ 2 but not 0 ~ 2
+	near := func(a, b int) bool { return max(a-b, b-a) <= 1 }
+	log = &failureLog{}
+	lawtest.Transitive(log, near, lawtest.IntGen(0, 9))
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Transitivity failed") {
+		t.Errorf("Expected nearness to fail transitivity, got %q", log.errors)
+	}
+
+	log = &failureLog{}
+	lawtest.TransitiveSeeds(log, near, []int{0, 1, 2})
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "a=0, b=1, c=2") {
+		t.Errorf("Expected the seeds 0, 1, 2 to break transitivity, got %q", log.errors)
+	}
+}
+
+// Testing order relations
+func TestOrders(t *testing.T) {
+	t.Run("IntLess", func(t *testing.T) {
+		less := func(a, b int) bool { return a < b }
+		lawtest.TestTotalOrder(t, less, lawtest.IntGen(-100, 100))
+	})
+
+	t.Run("PointLexicographic", func(t *testing.T) {
+		less := func(a, b Point) bool {
+			if a.X != b.X {
+				return a.X < b.X
+			}
+			return a.Y < b.Y
+		}
+		gen := func() Point {
+			intGen := lawtest.IntGen(-5, 5)
+			return Point{X: intGen(), Y: intGen()}
+		}
+		lawtest.TestTotalOrder(t, less, gen)
+	})
+
+	t.Run("ProperSubset", func(t *testing.T) {
+		properSubset := func(a, b uint8) bool { return a != b && a&b == a }
+		gen := func() uint8 { return uint8(lawtest.IntGen(0, 15)()) }
+		lawtest.TestPartialOrder(t, properSubset, gen)
+	})
+}
+
+// Testing that broken comparators are caught and shrunk
+func TestOrderFailures(t *testing.T) {
+	cfg := lawtest.DefaultConfig()
+	cfg.Shrinker = lawtest.IntShrinker()
+
+	// <= relates every value to itself
+	lessEq := func(a, b int) bool { return a <= b }
+	log := &failureLog{}
+	lawtest.IrreflexiveWithConfig(log, lessEq, lawtest.IntGen(-100, 100), cfg)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Irreflexivity failed") || !strings.Contains(log.errors[0], "a=0\n") {
+		t.Errorf("Expected <= to fail irreflexivity at a=0, got %q", log.errors)
+	}
+
+	// Comparing by tens returns true for both orders within a decade
+	byTens := func(a, b int) bool { return a/10 <= b/10 }
+	log = &failureLog{}
+	lawtest.AsymmetricWithConfig(log, byTens, lawtest.IntGen(0, 99), cfg)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Asymmetry failed") {
+		t.Errorf("Expected the comparator to fail asymmetry, got %q", log.errors)
+	}
+
+	log = &failureLog{}
+	lawtest.TotalWithConfig(log, func(a, b int) bool { return a/10 < b/10 }, lawtest.IntGen(0, 99), cfg)
+	if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Totality failed") {
+		t.Errorf("Expected values in one decade to fail totality, got %q", log.errors)
+	}
+}
@@ -0,0 +1,340 @@
+package lawtest
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ===========================================================================
+// CASE RUNNER
+// ===========================================================================
+
+// maxReportedFailures bounds how many distinct failures a ReportAll run
+// collects before it stops.
+const maxReportedFailures = 10
+
+// caseRun is the outcome of driving a property's test-case loop.
+type caseRun struct {
+	seed      int64                  // Seed the built-in generators were driven by
+	total     int                    // Number of cases requested
+	completed int                    // Number of cases that passed before the run stopped
+	failures  []string               // Distinct failure messages, empty if no case failed
+	timedOut  bool                   // Whether the run was cut short by cfg.Timeout
+	canceled  error                  // Context error if the run was cut short by cfg.ctx
+	sources   map[string]SourceStats // Contribution of labeled generators, nil if none was drawn from
+
+	panicked   bool // Whether check panicked
+	panicValue any  // Value recovered from the panic
+}
+
+// runCases drives the test-case loop shared by the property functions.
+//
+// check is called with the case index and returns a non-empty failure
+// message when that case violates the property. The loop stops at the first
+// failure, or with cfg.ReportAll once every case has run or
+// maxReportedFailures distinct failures have been seen. If cfg.Timeout is
+// positive and the loop hasn't finished by then, the run is abandoned and
+// reported as timed out; likewise it is reported as canceled if the context
+// attached by a WithContext function is done first.
+func runCases(cfg *Config, check func(i int) string) caseRun {
+	run := caseRun{total: cfg.TestCases}
+
+	var (
+		completed atomic.Int64
+		stopped   atomic.Bool
+		done      = make(chan struct{})
+	)
+
+	// The loop runs in its own goroutine so a hung operation can't block
+	// the test. After a timeout it may keep running in the background, but
+	// it stops at the next case boundary and its results are discarded.
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				run.panicked = true
+				run.panicValue = r
+			}
+		}()
+
+		seen := map[string]bool{}
+		for i := 0; i < cfg.TestCases && !stopped.Load(); i++ {
+			msg := check(i)
+			cfg.sources.endCase(msg == "")
+			if msg == "" {
+				completed.Add(1)
+				continue
+			}
+
+			if !seen[msg] {
+				seen[msg] = true
+				run.failures = append(run.failures, msg)
+			}
+			if !cfg.ReportAll || len(run.failures) >= maxReportedFailures {
+				return
+			}
+		}
+	}()
+
+	var timeout <-chan time.Time
+	if cfg.Timeout > 0 {
+		timer := time.NewTimer(cfg.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var canceled <-chan struct{}
+	if cfg.ctx != nil {
+		canceled = cfg.ctx.Done()
+	}
+
+	select {
+	case <-done:
+		run.completed = int(completed.Load())
+		return run
+
+	case <-timeout:
+		stopped.Store(true)
+		return caseRun{
+			total:     cfg.TestCases,
+			completed: int(completed.Load()),
+			timedOut:  true,
+		}
+
+	case <-canceled:
+		stopped.Store(true)
+		return caseRun{
+			total:     cfg.TestCases,
+			completed: int(completed.Load()),
+			canceled:  context.Cause(cfg.ctx),
+		}
+	}
+}
+
+// message describes why the run failed, or returns "" if it passed.
+func (r caseRun) message(cfg *Config) string {
+	if r.timedOut {
+		return fmt.Sprintf("Property test timed out after %v (%d of %d cases completed)",
+			cfg.Timeout, r.completed, r.total)
+	}
+	if r.canceled != nil {
+		return fmt.Sprintf("Property test canceled: %v (%d of %d cases completed)",
+			r.canceled, r.completed, r.total)
+	}
+
+	switch len(r.failures) {
+	case 0:
+		return ""
+	case 1:
+		return r.failures[0]
+	default:
+		return fmt.Sprintf("%d distinct failures (%d of %d cases passed):\n\n%s",
+			len(r.failures), r.completed, r.total, strings.Join(r.failures, "\n\n"))
+	}
+}
+
+// report translates the run into test output and reports whether it passed.
+//
+// A panic inside the operation is re-raised on the test goroutine so it
+// surfaces exactly as it would without the runner.
+func (r caseRun) report(t TB, cfg *Config) bool {
+	t.Helper()
+
+	if r.panicked {
+		panic(r.panicValue)
+	}
+
+	if msg := r.message(cfg); msg != "" {
+		t.Errorf("%s\n  %s", msg, r.reproduce())
+		return false
+	}
+
+	return true
+}
+
+// reproduce tells the user how to replay the run.
+func (r caseRun) reproduce() string {
+	return fmt.Sprintf("lawtest: seed=%d (set Config.Seed or -lawtest.seed to reproduce)", r.seed)
+}
+
+// seedFlag overrides Config.Seed for every property run, so that a failure
+// can be replayed from the command line with go test -lawtest.seed=N.
+var seedFlag = flag.Int64("lawtest.seed", 0, "seed for every lawtest property run, overriding Config.Seed (0 leaves it alone)")
+
+// resolveSeed returns the -lawtest.seed flag if set, otherwise cfg.Seed, or
+// a time-based seed if neither was set.
+func resolveSeed(cfg *Config) int64 {
+	if *seedFlag != 0 {
+		return *seedFlag
+	}
+	if cfg.Seed != 0 {
+		return cfg.Seed
+	}
+	return time.Now().UnixNano()
+}
+
+// pinSeed returns a copy of cfg with its seed resolved, so that the seed can
+// be logged before the run starts. A seed given with -lawtest.seed is always
+// logged.
+func pinSeed(t TB, cfg *Config) *Config {
+	t.Helper()
+
+	pinned := *cfg
+	pinned.Seed = resolveSeed(cfg)
+	if cfg.LogSeed || *seedFlag != 0 {
+		t.Logf("lawtest: seed=%d", pinned.Seed)
+	}
+	return &pinned
+}
+
+// logIgnoredOptions logs the options set in cfg that only the Check-based
+// properties (Associative, Commutative, Identity, Inverse, Idempotent and
+// Closure) honor, for properties whose cases don't expose their inputs.
+func logIgnoredOptions(t TB, cfg *Config) {
+	t.Helper()
+
+	if cfg.Classify != nil {
+		t.Logf("lawtest: Config.Classify is ignored by this property; only Associative, Commutative, Identity, Inverse, Idempotent and Closure classify their inputs")
+	}
+	if cfg.FuzzCorpusDir != "" {
+		t.Logf("lawtest: Config.FuzzCorpusDir is ignored by this property; only Associative, Commutative, Identity, Inverse, Idempotent and Closure save their counterexamples")
+	}
+}
+
+// runSeeded reseeds the built-in generators and runs the case loop, so that
+// the run can be replayed from the seed it reports.
+func runSeeded(cfg *Config, check func(i int) string) caseRun {
+	seed := resolveSeed(cfg)
+	defaultRand.Seed(seed)
+	cfg.sources.reset()
+
+	run := runCases(cfg, check)
+	run.seed = seed
+	run.sources = cfg.sources.get()
+	return run
+}
+
+// checkCases runs the case loop under cfg and reports the outcome to t.
+func checkCases(t TB, cfg *Config, check func(i int) string) bool {
+	t.Helper()
+
+	cfg = pinSeed(t, cfg)
+	logIgnoredOptions(t, cfg)
+	return runSeeded(cfg, check).report(t, cfg)
+}
+
+// ===========================================================================
+// RESULTS
+// ===========================================================================
+
+// Result is the outcome of checking a property without a *testing.T.
+//
+// The Check functions return a Result so that properties can be verified
+// from fuzzing harnesses, CLI validators and other code outside go test.
+// A panic inside the operation propagates to the caller as usual.
+//
+// Example:
+//
+//	res := lawtest.CheckAssociative(merge, gen, lawtest.DefaultConfig())
+//	if !res.Passed {
+//	    log.Fatalf("merge is not associative: %v\n%s", res.Counterexample, res.Message)
+//	}
+type Result[T any] struct {
+	Passed         bool   // Whether every case satisfied the property
+	CasesRun       int    // Number of cases that passed before the run stopped
+	Counterexample []T    // Inputs of the first failing case, nil if none failed
+	Message        string // Failure description, empty if the property held
+	Seed           int64  // Seed the run can be replayed from
+	TimedOut       bool   // Whether the run was cut short by Config.Timeout
+	Canceled       bool   // Whether the run was cut short by the context of a WithContext function
+	Stats          Stats  // Summary of the inputs the property was checked against
+
+	run caseRun
+	cfg *Config
+}
+
+// newResult builds a Result from a finished run, re-raising any panic.
+func newResult[T any](cfg *Config, run caseRun, ex *counterexample[T]) Result[T] {
+	if run.panicked {
+		panic(run.panicValue)
+	}
+
+	msg := run.message(cfg)
+	res := Result[T]{
+		Passed:   msg == "",
+		CasesRun: run.completed,
+		Message:  msg,
+		Seed:     run.seed,
+		TimedOut: run.timedOut,
+		Canceled: run.canceled != nil,
+		Stats:    ex.stats.get(),
+		run:      run,
+		cfg:      cfg,
+	}
+	res.Stats.Sources = run.sources
+	if !res.Passed {
+		res.Counterexample = ex.get()
+	}
+	return res
+}
+
+// report translates the result into test output and reports whether the
+// property held, logging the input statistics first if cfg.Verbose or
+// cfg.Classify is set and saving a counterexample to cfg.FuzzCorpusDir.
+func (r Result[T]) report(t TB) bool {
+	t.Helper()
+
+	if r.cfg.Verbose || r.cfg.Classify != nil {
+		t.Logf("%v", r.Stats)
+	}
+	if r.run.report(t, r.cfg) {
+		return true
+	}
+
+	if r.cfg.FuzzCorpusDir != "" && r.Counterexample != nil {
+		writeFuzzCorpus(t, r.cfg.FuzzCorpusDir, r.Counterexample)
+	}
+	return false
+}
+
+// counterexample records the inputs of the first failing case, along with
+// statistics over the inputs of every case.
+//
+// The case loop runs on its own goroutine and may still be running after a
+// timeout, so access is synchronized.
+type counterexample[T any] struct {
+	mu    sync.Mutex
+	args  []T
+	stats statsCollector[T]
+}
+
+func (c *counterexample[T]) record(args []T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.args == nil {
+		c.args = args
+	}
+}
+
+func (c *counterexample[T]) get() []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.args
+}
+
+// failing records args in the statistics, runs shrinkFailure on them and
+// records the counterexample it reports, if any.
+func (c *counterexample[T]) failing(cfg *Config, args []T, check func(args []T) string) string {
+	c.stats.observe(cfg, args)
+
+	msg, shrunk := shrinkFailure(cfg, args, check)
+	if msg != "" {
+		c.record(shrunk)
+	}
+	return msg
+}
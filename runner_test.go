@@ -0,0 +1,208 @@
+package lawtest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunCasesCompletes(t *testing.T) {
+	cfg := DefaultConfig()
+
+	run := runCases(cfg, func(int) string { return "" })
+	if run.timedOut || len(run.failures) != 0 {
+		t.Fatalf("Expected clean run, got %+v", run)
+	}
+	if run.completed != cfg.TestCases {
+		t.Errorf("Expected %d completed cases, got %d", cfg.TestCases, run.completed)
+	}
+}
+
+func TestRunCasesStopsAtFirstFailure(t *testing.T) {
+	calls := 0
+	run := runCases(DefaultConfig(), func(i int) string {
+		calls++
+		if i == 9 {
+			return "boom"
+		}
+		return ""
+	})
+
+	if len(run.failures) != 1 || run.failures[0] != "boom" {
+		t.Errorf("Expected failure message, got %q", run.failures)
+	}
+	if run.completed != 9 || calls != 10 {
+		t.Errorf("Expected 9 completed cases and 10 calls, got %d and %d", run.completed, calls)
+	}
+}
+
+func TestRunCasesTimeout(t *testing.T) {
+	cfg := &Config{TestCases: 100, Timeout: 50 * time.Millisecond}
+
+	// A deliberately slow operation that would take 2s to finish
+	slowOp := func(a, b int) int {
+		time.Sleep(20 * time.Millisecond)
+		return a + b
+	}
+
+	start := time.Now()
+	run := runCases(cfg, func(int) string {
+		_ = slowOp(1, 2)
+		return ""
+	})
+
+	if !run.timedOut {
+		t.Fatal("Expected run to time out")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Timeout fired too late: %v", elapsed)
+	}
+	if run.completed >= run.total {
+		t.Errorf("Expected partial progress, got %d of %d", run.completed, run.total)
+	}
+}
+
+func TestRunCasesNoTimeout(t *testing.T) {
+	cfg := &Config{TestCases: 5, Timeout: 0}
+
+	run := runCases(cfg, func(int) string {
+		time.Sleep(time.Millisecond)
+		return ""
+	})
+	if run.timedOut || run.completed != 5 {
+		t.Errorf("Expected all cases without a limit, got %+v", run)
+	}
+}
+
+func TestRunCasesPanic(t *testing.T) {
+	run := runCases(DefaultConfig(), func(int) string {
+		panic("op exploded")
+	})
+
+	defer func() {
+		r := recover()
+		if r == nil || !strings.Contains(r.(string), "op exploded") {
+			t.Errorf("Expected panic to be re-raised, got %v", r)
+		}
+	}()
+	run.report(t, DefaultConfig())
+}
+
+func TestRunCasesReportAll(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ReportAll = true
+
+	run := runCases(cfg, func(i int) string {
+		switch {
+		case i%10 == 0:
+			return "multiple of ten"
+		case i%25 == 1:
+			return "one past a quarter"
+		}
+		return ""
+	})
+
+	if len(run.failures) != 2 {
+		t.Fatalf("Expected 2 distinct failures, got %q", run.failures)
+	}
+	if run.completed != 86 {
+		t.Errorf("Expected every passing case to run, got %d completed", run.completed)
+	}
+
+	run = runCases(cfg, func(i int) string { return fmt.Sprintf("case %d", i) })
+	if len(run.failures) != maxReportedFailures || run.completed != 0 {
+		t.Errorf("Expected to stop after %d failures, got %d", maxReportedFailures, len(run.failures))
+	}
+}
+
+// logRecorder is a TB that keeps log lines
+type logRecorder struct {
+	recorder
+	logs []string
+}
+
+func (l *logRecorder) Logf(format string, args ...any) {
+	l.logs = append(l.logs, fmt.Sprintf(format, args...))
+}
+
+func TestWithSeed(t *testing.T) {
+	defer func(old int64) { *seedFlag = old }(*seedFlag)
+	*seedFlag = 0
+
+	cfg := WithSeed(1234)
+	if cfg.Seed != 1234 || !cfg.LogSeed || cfg.TestCases != defaultTestCases {
+		t.Fatalf("Expected the default config seeded with 1234, got %+v", cfg)
+	}
+
+	log := &logRecorder{}
+	if pinned := pinSeed(log, cfg); pinned.Seed != 1234 || len(log.logs) != 1 || log.logs[0] != "lawtest: seed=1234" {
+		t.Errorf("Expected seed 1234 to be pinned and logged, got %d and %q", pinned.Seed, log.logs)
+	}
+}
+
+func TestSeedFlagOverridesConfig(t *testing.T) {
+	defer func(old int64) { *seedFlag = old }(*seedFlag)
+	*seedFlag = 77
+
+	cfg := DefaultConfig()
+	cfg.Seed = 5
+
+	log := &logRecorder{}
+	if pinned := pinSeed(log, cfg); pinned.Seed != 77 || len(log.logs) != 1 {
+		t.Errorf("Expected the flag seed to be pinned and logged, got %d and %q", pinned.Seed, log.logs)
+	}
+	if cfg.Rand().Int63() != NewRand(77).Int63() {
+		t.Error("Expected Config.Rand to follow the flag seed")
+	}
+	if run := runSeeded(cfg, func(int) string { return "" }); run.seed != 77 {
+		t.Errorf("Expected the run to use the flag seed, got %d", run.seed)
+	}
+}
+
+// Test that the seed replays the built-in generators of a run on its own,
+// and generators drawing from Config.Rand even alongside concurrent runs
+func TestSeedReplaysRuns(t *testing.T) {
+	sample := func(newGen func(*Config) Generator[int]) []int {
+		cfg := DefaultConfig()
+		cfg.Seed = 42
+		gen := newGen(cfg)
+
+		var drawn []int
+		runSeeded(cfg, func(int) string {
+			drawn = append(drawn, gen(), gen())
+			return ""
+		})
+		return drawn
+	}
+	shared := func(*Config) Generator[int] { return IntGen(0, 1_000_000) }
+	own := func(cfg *Config) Generator[int] { return IntGenSeeded(0, 1_000_000, cfg.Rand()) }
+
+	if !slices.Equal(sample(shared), sample(shared)) {
+		t.Error("Expected the built-in generators to replay from the same seed")
+	}
+
+	want := sample(own)
+	samples := make([][]int, 8)
+	var wg sync.WaitGroup
+	for i := range samples {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			samples[i] = sample(own)
+		}(i)
+		go func() {
+			defer wg.Done()
+			sample(shared)
+		}()
+	}
+	wg.Wait()
+
+	for i, got := range samples {
+		if !slices.Equal(got, want) {
+			t.Fatalf("Run %d drew different inputs from the same seed", i)
+		}
+	}
+}
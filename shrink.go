@@ -0,0 +1,277 @@
+package lawtest
+
+// ===========================================================================
+// SHRINKING
+// ===========================================================================
+
+// maxShrinkSteps bounds how many candidates are tried while minimizing a
+// counterexample, so a shrinker that never converges can't hang the test.
+// Config.MaxShrink overrides it.
+const maxShrinkSteps = 1000
+
+// Shrinker returns simpler variants of a value, most aggressive first.
+//
+// When a property fails and Config.Shrinker holds a Shrinker for the
+// property's element type, the failing inputs are repeatedly replaced by
+// shrunk candidates that still fail, and the simplest counterexample found
+// is reported instead of the original random one.
+//
+// Candidates should be strictly simpler than the input; returning the input
+// itself only wastes shrink steps.
+//
+// Shrinking is applied by Associative, Commutative, Identity, Inverse,
+// Idempotent, Closure, AssociativeCustom and the order relation properties
+// (Irreflexive, Asymmetric, Transitive and Total). Config.Shrinker also
+// accepts any Shrinkable[T], such as IntShrinker or a user type with a
+// Shrink method. For types no Shrinker fits, Config.ShrinkFunc shrinks
+// values passed as any; candidates of the wrong type are ignored.
+// Config.MaxShrink bounds the candidates tried.
+//
+// Example:
+//
+//	cfg := lawtest.DefaultConfig()
+//	cfg.Shrinker = lawtest.Shrinker[int](lawtest.ShrinkInt)
+//	lawtest.AssociativeWithConfig(t, op, lawtest.IntGen(-100000, 100000), cfg)
+//	// Associativity failed: (a∘b)∘c != a∘(b∘c)
+//	//   a=0, b=1, c=0 ...
+type Shrinker[T any] func(T) []T
+
+// Shrink returns the candidates s produces for v, making every Shrinker a
+// Shrinkable.
+func (s Shrinker[T]) Shrink(v T) []T {
+	return s(v)
+}
+
+// Shrinkable is implemented by anything that can propose simpler variants
+// of a T, most aggressive first.
+//
+// It lets shrinking compose with user types: a Group, Monoid, Semigroup or
+// IdempotentOp implementation that also has a Shrink method has its
+// counterexamples minimized by TestGroup and friends without any
+// configuration, and a Shrinkable can be set as Config.Shrinker directly.
+//
+// Example:
+//
+//	type Version struct{}
+//
+//	func (Version) Op(a, b Semver) Semver { return a.Max(b) }
+//	func (Version) Gen() Semver           { return genSemver() }
+//	func (Version) Shrink(v Semver) []Semver {
+//	    return []Semver{{}, {Major: v.Major}, {Major: v.Major, Minor: v.Minor}}
+//	}
+//
+//	lawtest.TestSemigroup[Semver](t, Version{}) // failures report the simplest version
+type Shrinkable[T any] interface {
+	Shrink(T) []T
+}
+
+// IntShrinker returns a Shrinkable that shrinks integers toward zero.
+func IntShrinker() Shrinkable[int] {
+	return Shrinker[int](ShrinkInt)
+}
+
+// StringShrinker returns a Shrinkable that shrinks strings toward the empty
+// string by dropping runes.
+func StringShrinker() Shrinkable[string] {
+	return Shrinker[string](ShrinkString)
+}
+
+// SliceShrinker returns a Shrinkable that shrinks slices by dropping
+// elements and then, if elem is not nil, by shrinking each element in place.
+//
+// Example:
+//
+//	cfg := lawtest.DefaultConfig()
+//	cfg.Shrinker = lawtest.SliceShrinker(lawtest.IntShrinker())
+//	lawtest.AssociativeCustomWithConfig(t, merge, lawtest.SliceGen(lawtest.IntGen(-100, 100), 0, 20), slices.Equal[[]int], cfg)
+//	// a=[0], b=[], c=[1] ...
+func SliceShrinker[T any](elem Shrinkable[T]) Shrinkable[[]T] {
+	return Shrinker[[]T](func(s []T) [][]T {
+		candidates := ShrinkSlice(s)
+		if elem == nil {
+			return candidates
+		}
+
+		for i, v := range s {
+			for _, c := range elem.Shrink(v) {
+				shrunk := append([]T(nil), s...)
+				shrunk[i] = c
+				candidates = append(candidates, shrunk)
+			}
+		}
+		return candidates
+	})
+}
+
+// ShrinkInt shrinks an integer toward zero.
+func ShrinkInt(n int) []int {
+	if n == 0 {
+		return nil
+	}
+
+	candidates := []int{0}
+	if half := n / 2; half != 0 {
+		candidates = append(candidates, half)
+	}
+	if n < 0 {
+		candidates = append(candidates, -n, n+1)
+	} else {
+		candidates = append(candidates, n-1)
+	}
+	return candidates
+}
+
+// ShrinkString shrinks a string toward the empty string by dropping runes.
+func ShrinkString(s string) []string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return nil
+	}
+
+	candidates := []string{""}
+	if len(runes) > 1 {
+		candidates = append(candidates, string(runes[:len(runes)/2]), string(runes[len(runes)/2:]))
+	}
+	for i := range runes {
+		candidates = append(candidates, string(runes[:i])+string(runes[i+1:]))
+	}
+	return candidates
+}
+
+// ShrinkSlice shrinks a slice toward the empty slice by dropping elements.
+//
+// Elements themselves are left as they are. Use it as a Shrinker with an
+// explicit instantiation:
+//
+//	cfg.Shrinker = lawtest.Shrinker[[]int](lawtest.ShrinkSlice[int])
+func ShrinkSlice[T any](s []T) [][]T {
+	if len(s) == 0 {
+		return nil
+	}
+
+	candidates := [][]T{{}}
+	if len(s) > 1 {
+		candidates = append(candidates, append([]T(nil), s[:len(s)/2]...), append([]T(nil), s[len(s)/2:]...))
+	}
+	for i := range s {
+		dropped := make([]T, 0, len(s)-1)
+		dropped = append(dropped, s[:i]...)
+		dropped = append(dropped, s[i+1:]...)
+		candidates = append(candidates, dropped)
+	}
+	return candidates
+}
+
+// shrinkerFor returns the shrinker configured for T, or nil if there is none.
+//
+// A Config.Shrinker for T takes precedence over Config.ShrinkFunc, whose
+// candidates are filtered down to those of type T.
+func shrinkerFor[T any](cfg *Config) Shrinker[T] {
+	switch s := cfg.Shrinker.(type) {
+	case Shrinker[T]:
+		return s
+	case func(T) []T:
+		return s
+	case Shrinkable[T]:
+		return s.Shrink
+	}
+
+	if cfg.ShrinkFunc == nil {
+		return nil
+	}
+	return func(v T) []T {
+		var candidates []T
+		for _, c := range cfg.ShrinkFunc(v) {
+			if c, ok := c.(T); ok {
+				candidates = append(candidates, c)
+			}
+		}
+		return candidates
+	}
+}
+
+// shrinkLimit returns the number of shrink candidates cfg allows to be tried.
+func shrinkLimit(cfg *Config) int {
+	if cfg.MaxShrink > 0 {
+		return cfg.MaxShrink
+	}
+	return maxShrinkSteps
+}
+
+// shrinkingConfig returns cfg with v as its shrinker if cfg has none and v
+// is Shrinkable, so that structures carrying a Shrink method shrink their
+// own counterexamples.
+func shrinkingConfig[T any](cfg *Config, v any) *Config {
+	s, ok := v.(Shrinkable[T])
+	if !ok || cfg.Shrinker != nil || cfg.ShrinkFunc != nil {
+		return cfg
+	}
+
+	shrinking := *cfg
+	shrinking.Shrinker = s
+	return &shrinking
+}
+
+// shrinkFailure checks args and, if they fail, reports the failure for the
+// simplest failing arguments the configured shrinker can find, along with
+// those arguments.
+//
+// check returns a non-empty failure message when args violate the property.
+// Arguments are shrunk one position at a time, keeping the first
+// candidate that still fails, until no candidate fails or shrinkLimit
+// candidates have been tried. Candidates the property panics on, such as
+// the 0 ShrinkInt tries first for a division, are skipped.
+func shrinkFailure[T any](cfg *Config, args []T, check func(args []T) string) (string, []T) {
+	msg := check(args)
+	if msg == "" {
+		return "", nil
+	}
+
+	shrink := shrinkerFor[T](cfg)
+	if shrink == nil {
+		return msg, args
+	}
+
+	current := append([]T(nil), args...)
+	shrinks, steps, limit := 0, 0, shrinkLimit(cfg)
+
+	for improved := true; improved && steps < limit; {
+		improved = false
+
+		for i := 0; i < len(current) && !improved; i++ {
+			for _, candidate := range shrink(current[i]) {
+				if steps >= limit {
+					break
+				}
+				steps++
+
+				trial := append([]T(nil), current...)
+				trial[i] = candidate
+				if m := checkCandidate(check, trial); m != "" {
+					current, msg = trial, m
+					shrinks++
+					improved = true
+					break
+				}
+			}
+		}
+	}
+
+	if shrinks == 0 {
+		return msg, current
+	}
+
+	return cfg.sprintf("%s\n  shrunk from %v in %d steps", msg, args, shrinks), current
+}
+
+// checkCandidate checks a shrink candidate, treating a panic as a pass so
+// that the candidate is skipped rather than replacing the failure being
+// shrunk.
+func checkCandidate[T any](check func(args []T) string, trial []T) (msg string) {
+	defer func() {
+		if recover() != nil {
+			msg = ""
+		}
+	}()
+	return check(trial)
+}
@@ -0,0 +1,223 @@
+package lawtest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShrinkFailureMinimizes(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Shrinker = Shrinker[int](ShrinkInt)
+
+	// Fails whenever the sum is at least 10; the simplest case puts all the
+	// weight on one argument.
+	check := func(v []int) string {
+		if v[0]+v[1] >= 10 {
+			return "sum too large"
+		}
+		return ""
+	}
+
+	msg, got := shrinkFailure(cfg, []int{8317, 4425}, check)
+
+	if msg == "" {
+		t.Fatal("Expected a failure message")
+	}
+	if !strings.Contains(msg, "shrunk from [8317 4425]") {
+		t.Errorf("Expected the original arguments in the message, got %q", msg)
+	}
+	if got[0] != 0 || got[1] != 10 {
+		t.Errorf("Expected minimal counterexample [0 10], got %v", got)
+	}
+}
+
+func TestShrinkFailureWithoutShrinker(t *testing.T) {
+	msg, args := shrinkFailure(DefaultConfig(), []int{5}, func(v []int) string { return "fail" })
+	if msg != "fail" || args[0] != 5 {
+		t.Errorf("Expected the unshrunk message, got %q", msg)
+	}
+
+	msg, args = shrinkFailure(DefaultConfig(), []int{5}, func(v []int) string { return "" })
+	if msg != "" || args != nil {
+		t.Errorf("Expected no message for a passing case, got %q", msg)
+	}
+}
+
+func TestShrinkFailureSkipsPanickingCandidates(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Seed = 1 // The first case fails without dividing by zero
+	cfg.Shrinker = IntShrinker()
+
+	// ShrinkInt tries 0 first, which the division panics on
+	div := func(a, b int) int { return a / b }
+	res := CheckAssociative(div, NonZeroIntGen(1, 100), cfg)
+
+	if res.Passed || !strings.Contains(res.Message, "Associativity failed") {
+		t.Fatalf("Expected the associativity failure to be reported, got %q", res.Message)
+	}
+	for _, v := range res.Counterexample {
+		if v == 0 {
+			t.Errorf("Expected the counterexample to skip the panicking 0, got %v", res.Counterexample)
+		}
+	}
+}
+
+func TestShrinkFailureAcceptsPlainFunc(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Shrinker = ShrinkString
+
+	var got string
+	shrinkFailure(cfg, []string{"xxaxx"}, func(v []string) string {
+		if strings.Contains(v[0], "a") {
+			got = v[0]
+			return "contains a"
+		}
+		return ""
+	})
+
+	if got != "a" {
+		t.Errorf("Expected minimal counterexample %q, got %q", "a", got)
+	}
+}
+
+// point is a type with no built-in shrinker
+type point struct{ x, y int }
+
+func TestShrinkFuncShrinksAnyType(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ShrinkFunc = func(v any) []any {
+		p, ok := v.(point)
+		if !ok {
+			return nil
+		}
+		var candidates []any
+		for _, x := range ShrinkInt(p.x) {
+			candidates = append(candidates, point{x, p.y})
+		}
+		for _, y := range ShrinkInt(p.y) {
+			candidates = append(candidates, point{p.x, y})
+		}
+		return append(candidates, "not a point") // ignored
+	}
+
+	check := func(v []point) string {
+		if v[0].x+v[0].y >= 10 {
+			return "too far"
+		}
+		return ""
+	}
+
+	_, got := shrinkFailure(cfg, []point{{831, 442}}, check)
+	if got[0] != (point{0, 10}) {
+		t.Errorf("Expected minimal counterexample {0 10}, got %v", got)
+	}
+
+	// A typed Shrinker takes precedence
+	cfg.Shrinker = Shrinker[point](func(point) []point { return nil })
+	if _, got := shrinkFailure(cfg, []point{{831, 442}}, check); got[0] != (point{831, 442}) {
+		t.Errorf("Expected the Shrinker to override ShrinkFunc, got %v", got)
+	}
+}
+
+func TestMaxShrinkBoundsSteps(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MaxShrink = 3
+
+	tried := 0
+	cfg.ShrinkFunc = func(v any) []any {
+		return []any{v.(int) + 1} // never converges
+	}
+	shrinkFailure(cfg, []int{0}, func(v []int) string {
+		tried++
+		return "always fails"
+	})
+
+	if tried != 1+cfg.MaxShrink {
+		t.Errorf("Expected the original check and %d shrink steps, got %d checks", cfg.MaxShrink, tried)
+	}
+}
+
+func TestShrinkSlice(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Shrinker = Shrinker[[]int](ShrinkSlice[int])
+
+	var got []int
+	shrinkFailure(cfg, [][]int{{4, 7, 1, 9, 3}}, func(v [][]int) string {
+		for _, x := range v[0] {
+			if x == 9 {
+				got = v[0]
+				return "contains 9"
+			}
+		}
+		return ""
+	})
+
+	if len(got) != 1 || got[0] != 9 {
+		t.Errorf("Expected minimal counterexample [9], got %v", got)
+	}
+
+	if c := ShrinkSlice([]int{}); c != nil {
+		t.Errorf("Expected no candidates for an empty slice, got %v", c)
+	}
+}
+
+func TestShrinkIntTerminates(t *testing.T) {
+	for _, n := range []int{-1000, -1, 0, 1, 1000} {
+		steps := 0
+		for v := n; v != 0; steps++ {
+			c := ShrinkInt(v)
+			v = c[len(c)-1] // slowest path toward zero
+			if steps > 1000 {
+				t.Fatalf("ShrinkInt(%d) did not converge", n)
+			}
+		}
+	}
+}
+
+func TestSliceShrinkerShrinksElements(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Shrinker = SliceShrinker(IntShrinker())
+
+	var got []int
+	shrinkFailure(cfg, [][]int{{4, 7, 1, 19, 3}}, func(v [][]int) string {
+		for _, x := range v[0] {
+			if x >= 9 {
+				got = v[0]
+				return "contains a large value"
+			}
+		}
+		return ""
+	})
+
+	if len(got) != 1 || got[0] != 9 {
+		t.Errorf("Expected minimal counterexample [9], got %v", got)
+	}
+
+	if c := SliceShrinker[int](nil).Shrink([]int{1, 2}); len(c) != len(ShrinkSlice([]int{1, 2})) {
+		t.Errorf("Expected only dropped elements without an element shrinker, got %v", c)
+	}
+}
+
+// shrinkingSubtraction is a broken group that knows how to shrink its elements
+type shrinkingSubtraction struct{}
+
+func (shrinkingSubtraction) Op(a, b int) int    { return a - b }
+func (shrinkingSubtraction) Identity() int      { return 0 }
+func (shrinkingSubtraction) Inverse(a int) int  { return a }
+func (shrinkingSubtraction) Gen() int           { return IntGen(-1000, 1000)() }
+func (shrinkingSubtraction) Shrink(a int) []int { return ShrinkInt(a) }
+
+func TestShrinkableGroup(t *testing.T) {
+	report := CheckGroup[int](shrinkingSubtraction{}, DefaultConfig())
+
+	got := report.Associativity.Counterexample
+	if len(got) != 3 || got[0] != 0 || got[1] != 0 || got[2] != 1 {
+		t.Errorf("Expected the group's own shrinker to minimize to [0 0 1], got %v", got)
+	}
+
+	cfg := DefaultConfig()
+	cfg.Shrinker = StringShrinker()
+	if shrinkingConfig[int](cfg, shrinkingSubtraction{}) != cfg {
+		t.Error("Expected an explicit Config.Shrinker to take precedence")
+	}
+}
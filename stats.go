@@ -0,0 +1,369 @@
+package lawtest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ===========================================================================
+// STATISTICS
+// ===========================================================================
+
+// Stats summarizes the inputs a property run was checked against.
+//
+// A property that passes against a handful of distinct inputs proves very
+// little; Stats makes a too-narrow generator visible. The Check functions
+// return it in Result.Stats, and the property functions built on them
+// (Associative, Commutative, Identity, Inverse, Idempotent and Closure) log
+// it when Config.Verbose or Config.Classify is set.
+//
+// Distinct is only counted when Config.Verbose or Config.Classify is set,
+// since it keeps every input for the length of the run; otherwise it is 0.
+//
+// Example:
+//
+//	cfg := lawtest.DefaultConfig()
+//	cfg.Verbose = true
+//	res := lawtest.CheckCommutative(add, lawtest.IntGen(5, 5), cfg)
+//	if res.Stats.Distinct < 10 {
+//	    t.Errorf("generator is too narrow: %v", res.Stats)
+//	}
+type Stats struct {
+	Cases    int     // Number of cases checked, including a failing one
+	Inputs   int     // Number of generated inputs across all cases
+	Distinct int     // Number of distinct inputs, counted with Config.Verbose or Config.Classify only
+	Numeric  bool    // Whether the inputs are numbers, so Min and Max are meaningful
+	Min      float64 // Smallest numeric input
+	Max      float64 // Largest numeric input
+
+	Labels  map[string]int         // Number of inputs per Config.Classify label, nil without a classifier
+	Sources map[string]SourceStats // Values and cases per Generator.Label name, nil without labeled generators
+}
+
+// SourceStats describes what a labeled generator contributed to a run.
+type SourceStats struct {
+	Values int // Number of values the generator produced
+	Passed int // Number of passing cases that drew at least one of them
+	Failed int // Number of failing cases that drew at least one of them
+}
+
+// String formats the statistics as a one-line summary, leaving out the
+// distinct count if it wasn't counted, followed by the label distribution
+// and labeled sources if there are any.
+func (s Stats) String() string {
+	summary := fmt.Sprintf("lawtest: %d cases, %d inputs", s.Cases, s.Inputs)
+	if s.Distinct > 0 {
+		summary += fmt.Sprintf(", %d distinct", s.Distinct)
+	}
+	if s.Numeric && s.Inputs > 0 {
+		summary += fmt.Sprintf(", range [%v, %v]", s.Min, s.Max)
+	}
+	if len(s.Labels) > 0 {
+		summary += "\n  labels: " + s.labelDistribution()
+	}
+	if len(s.Sources) > 0 {
+		summary += "\n  sources: " + s.sourceSummary()
+	}
+	return summary
+}
+
+// labelDistribution formats the share of inputs per label, most common
+// first.
+func (s Stats) labelDistribution() string {
+	labels := make([]string, 0, len(s.Labels))
+	for label := range s.Labels {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if s.Labels[labels[i]] != s.Labels[labels[j]] {
+			return s.Labels[labels[i]] > s.Labels[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+
+	dist := make([]string, len(labels))
+	for i, label := range labels {
+		dist[i] = fmt.Sprintf("%s %.1f%%", label, 100*float64(s.Labels[label])/float64(s.Inputs))
+	}
+	return strings.Join(dist, ", ")
+}
+
+// sourceSummary formats the contribution of each labeled generator, in
+// order of name.
+func (s Stats) sourceSummary() string {
+	names := make([]string, 0, len(s.Sources))
+	for name := range s.Sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sources := make([]string, len(names))
+	for i, name := range names {
+		src := s.Sources[name]
+		sources[i] = fmt.Sprintf("%s %d values in %d cases (%d passed", name, src.Values, src.Passed+src.Failed, src.Passed)
+		if src.Failed > 0 {
+			sources[i] += fmt.Sprintf(", %d failed", src.Failed)
+		}
+		sources[i] += ")"
+	}
+	return strings.Join(sources, ", ")
+}
+
+// Classifier labels a generated value for the input distribution report.
+type Classifier[T any] func(T) string
+
+// Classify wraps label as a Classifier for Config.Classify.
+//
+// Every input a property is checked against is labeled, and the number of
+// inputs per label is returned in Stats.Labels and logged after the run.
+// Use it to confirm that a generator actually reaches the regions a
+// property is meant to exercise.
+//
+// Only Associative, Commutative, Identity, Inverse, Idempotent and Closure
+// (with their Check and WithContext forms, and the structure testers built
+// on them such as TestGroup) see their inputs individually and classify
+// them. Other properties log that Config.Classify was ignored. A classifier
+// for a type other than the property's element type panics.
+//
+// Example:
+//
+//	cfg := lawtest.DefaultConfig()
+//	cfg.Classify = lawtest.Classify(func(n int) string {
+//	    switch {
+//	    case n < 0:
+//	        return "negative"
+//	    case n == 0:
+//	        return "zero"
+//	    }
+//	    return "positive"
+//	})
+//	lawtest.AssociativeWithConfig(t, add, lawtest.IntGen(0, 100), cfg)
+//	// lawtest: 100 cases, 300 inputs, 96 distinct, range [0, 100]
+//	//   labels: positive 99.3%, zero 0.7%
+func Classify[T any](label func(T) string) Classifier[T] {
+	return label
+}
+
+// classifierFor returns the classifier configured for T, or nil if there
+// is none. It panics if cfg.Classify is set but doesn't classify a T.
+func classifierFor[T any](cfg *Config) Classifier[T] {
+	switch c := cfg.Classify.(type) {
+	case nil:
+		return nil
+	case Classifier[T]:
+		return c
+	case func(T) string:
+		return c
+	}
+	panic(fmt.Sprintf("Config.Classify is a %T, not a Classifier[%v]", cfg.Classify, reflect.TypeOf((*T)(nil)).Elem()))
+}
+
+// statsCollector accumulates Stats as cases are checked.
+//
+// Like counterexample it is written from the case loop's goroutine, which
+// may outlive a timeout, so access is synchronized.
+type statsCollector[T any] struct {
+	mu    sync.Mutex
+	stats Stats
+	seen  map[any]bool
+}
+
+// observe records the inputs of one case, labeling them with the
+// classifier configured in cfg. Distinct inputs are only counted when cfg
+// asks for the statistics to be reported.
+func (c *statsCollector[T]) observe(cfg *Config, args []T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stats.Cases == 0 {
+		c.stats.Numeric = true
+	}
+
+	label := classifierFor[T](cfg)
+	if label != nil && c.stats.Labels == nil {
+		c.stats.Labels = map[string]int{}
+	}
+
+	distinct := cfg.Verbose || label != nil
+	if distinct && c.seen == nil {
+		c.seen = map[any]bool{}
+	}
+
+	c.stats.Cases++
+	for _, arg := range args {
+		c.stats.Inputs++
+
+		if distinct {
+			c.countDistinct(arg)
+		}
+
+		if label != nil {
+			c.stats.Labels[label(arg)]++
+		}
+
+		n, ok := numericValue(any(arg))
+		switch {
+		case !ok:
+			c.stats.Numeric = false
+		case c.stats.Inputs == 1:
+			c.stats.Min, c.stats.Max = n, n
+		case n < c.stats.Min:
+			c.stats.Min = n
+		case n > c.stats.Max:
+			c.stats.Max = n
+		}
+	}
+}
+
+// countDistinct counts arg if it hasn't been seen before. Values that can't
+// be map keys are compared by their %#v formatting.
+func (c *statsCollector[T]) countDistinct(arg T) {
+	var key any = arg
+	if v := reflect.ValueOf(key); !v.IsValid() || !v.Comparable() {
+		key = fmt.Sprintf("%#v", arg)
+	}
+	if !c.seen[key] {
+		c.seen[key] = true
+		c.stats.Distinct++
+	}
+}
+
+// get returns a snapshot of the statistics collected so far.
+func (c *statsCollector[T]) get() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	if stats.Labels != nil {
+		stats.Labels = make(map[string]int, len(c.stats.Labels))
+		for label, n := range c.stats.Labels {
+			stats.Labels[label] = n
+		}
+	}
+	return stats
+}
+
+// sourceTally accumulates Stats.Sources for the runs of one Config.
+//
+// Labeled generators record into it from the case loop's goroutine, which
+// may outlive a timeout, and from goroutines the property starts itself, so
+// access is synchronized. A nil *sourceTally ignores every call, for
+// Configs no generator was labeled with.
+type sourceTally struct {
+	mu      sync.Mutex
+	drawn   map[string]bool // Labels drawn from in the current case
+	sources map[string]SourceStats
+}
+
+// record counts a value produced by the generator labeled name.
+func (s *sourceTally) record(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sources == nil {
+		s.sources = map[string]SourceStats{}
+		s.drawn = map[string]bool{}
+	}
+	src := s.sources[name]
+	src.Values++
+	s.sources[name] = src
+	s.drawn[name] = true
+}
+
+// endCase attributes the outcome of the case that just ran to every label
+// drawn from during it.
+func (s *sourceTally) endCase(passed bool) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name := range s.drawn {
+		src := s.sources[name]
+		if passed {
+			src.Passed++
+		} else {
+			src.Failed++
+		}
+		s.sources[name] = src
+		delete(s.drawn, name)
+	}
+}
+
+// reset clears the tallies at the start of a run.
+func (s *sourceTally) reset() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sources, s.drawn = nil, nil
+}
+
+// get returns a copy of the tallies, or nil if no labeled generator was
+// drawn from.
+func (s *sourceTally) get() map[string]SourceStats {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sources == nil {
+		return nil
+	}
+	sources := make(map[string]SourceStats, len(s.sources))
+	for name, src := range s.sources {
+		sources[name] = src
+	}
+	return sources
+}
+
+// numericValue converts integer and floating-point values to float64.
+func numericValue(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// Histogram samples g n times and returns how many values fell into each
+// bucket.
+//
+// It is a standalone diagnostic for generator bias: run it in an example,
+// a main function or a test before trusting a generator with a property.
+// Unlike Config.Classify it needs no property run and no *testing.T.
+//
+// Example:
+//
+//	counts := lawtest.Histogram(lawtest.StringGen(8), 10000, func(s string) string {
+//	    switch c := s[0]; {
+//	    case c >= 'a' && c <= 'z':
+//	        return "lower"
+//	    case c >= 'A' && c <= 'Z':
+//	        return "upper"
+//	    }
+//	    return "digit"
+//	})
+//	fmt.Println(counts) // map[digit:1613 lower:4187 upper:4200]
+func Histogram[T any](g Generator[T], n int, bucket func(T) string) map[string]int {
+	counts := map[string]int{}
+	for i := 0; i < n; i++ {
+		counts[bucket(g())]++
+	}
+	return counts
+}
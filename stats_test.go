@@ -0,0 +1,147 @@
+package lawtest_test
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// logRecorder is a lawtest.TB that records log lines other than the seed,
+// which -lawtest.seed always logs
+type logRecorder struct {
+	failureLog
+	logs []string
+}
+
+func (l *logRecorder) Logf(format string, args ...any) {
+	if line := fmt.Sprintf(format, args...); !strings.HasPrefix(line, "lawtest: seed=") {
+		l.logs = append(l.logs, line)
+	}
+}
+
+// Test that a constant generator shows up as a single distinct input
+func TestStatsNarrowGenerator(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	cfg := lawtest.DefaultConfig()
+	cfg.Verbose = true
+
+	res := lawtest.CheckCommutative(add, lawtest.IntGen(5, 5), cfg)
+	want := lawtest.Stats{Cases: cfg.TestCases, Inputs: 2 * cfg.TestCases, Distinct: 1, Numeric: true, Min: 5, Max: 5}
+	if !reflect.DeepEqual(res.Stats, want) {
+		t.Errorf("Expected %+v, got %+v", want, res.Stats)
+	}
+
+	res = lawtest.CheckAssociative(add, lawtest.IntGen(-1000, 1000), cfg)
+	if s := res.Stats; s.Distinct < 100 || s.Min < -1000 || s.Max > 1000 || s.Min >= s.Max {
+		t.Errorf("Expected wide coverage, got %v", s)
+	}
+
+	concat := func(a, b string) string { return a + b }
+	if s := lawtest.CheckAssociative(concat, lawtest.StringGen(5), cfg).Stats; s.Numeric || strings.Contains(s.String(), "range") {
+		t.Errorf("Expected strings to have no numeric range, got %v", s)
+	}
+
+	// Distinct inputs aren't tracked unless the statistics are reported
+	if s := lawtest.CheckAssociative(concat, lawtest.StringGen(5), lawtest.DefaultConfig()).Stats; s.Distinct != 0 || strings.Contains(s.String(), "distinct") {
+		t.Errorf("Expected no distinct count without Verbose, got %v", s)
+	}
+}
+
+// Test that Verbose logs the summary
+func TestStatsVerbose(t *testing.T) {
+	log := &logRecorder{}
+	cfg := lawtest.DefaultConfig()
+	cfg.Verbose = true
+
+	lawtest.CommutativeWithConfig(log, func(a, b int) int { return a * b }, lawtest.IntGen(5, 5), cfg)
+
+	if len(log.logs) != 1 || log.logs[0] != "lawtest: 100 cases, 200 inputs, 1 distinct, range [5, 5]" {
+		t.Errorf("Expected one stats line, got %q", log.logs)
+	}
+}
+
+// Test that Classify tallies labeled inputs and reports the distribution
+func TestClassify(t *testing.T) {
+	sign := func(n int) string {
+		switch {
+		case n < 0:
+			return "negative"
+		case n == 0:
+			return "zero"
+		}
+		return "positive"
+	}
+
+	cfg := lawtest.DefaultConfig()
+	cfg.Classify = lawtest.Classify(sign)
+
+	res := lawtest.CheckAssociative(func(a, b int) int { return a + b }, lawtest.IntGen(1, 100), cfg)
+	if !reflect.DeepEqual(res.Stats.Labels, map[string]int{"positive": 3 * cfg.TestCases}) {
+		t.Errorf("Expected only positive inputs, got %v", res.Stats.Labels)
+	}
+
+	log := &logRecorder{}
+	cfg.Classify = sign // a plain func works too
+	lawtest.IdempotentWithConfig(log, func(n int) int { return n }, lawtest.IntGen(-1, 0), cfg)
+
+	if len(log.logs) != 1 || !strings.Contains(log.logs[0], "labels: ") || !strings.Contains(log.logs[0], "negative") || !strings.Contains(log.logs[0], "zero") {
+		t.Errorf("Expected the label distribution to be logged, got %q", log.logs)
+	}
+
+	if s := (lawtest.Stats{Inputs: 4, Labels: map[string]int{"b": 1, "a": 1, "c": 2}}); !strings.HasSuffix(s.String(), "labels: c 50.0%, a 25.0%, b 25.0%") {
+		t.Errorf("Expected labels sorted by frequency, got %q", s.String())
+	}
+}
+
+// Test that Classify is never dropped silently
+func TestClassifyScope(t *testing.T) {
+	cfg := lawtest.DefaultConfig()
+	cfg.Classify = lawtest.Classify(strconv.Itoa)
+
+	log := &logRecorder{}
+	double := func(n int) int { return 2 * n }
+	eq := func(a, b int) bool { return a == b }
+	lawtest.EquivalentCustomWithConfig(log, double, func(n int) int { return n + n }, lawtest.IntGen(-100, 100), eq, cfg)
+	if len(log.logs) == 0 || !strings.Contains(log.logs[0], "Config.Classify is ignored by this property") {
+		t.Errorf("Expected EquivalentCustom to log that it ignored the classifier, got %q", log.logs)
+	}
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "not a Classifier[string]") {
+			t.Errorf("Expected a classifier for the wrong type to panic, got %v", r)
+		}
+	}()
+	concat := func(a, b string) string { return a + b }
+	lawtest.CheckAssociative(concat, lawtest.StringGen(3), cfg)
+}
+
+// Test Histogram reveals the character mix of StringGen
+func TestHistogram(t *testing.T) {
+	class := func(s string) string {
+		switch c := s[0]; {
+		case c >= 'a' && c <= 'z':
+			return "lower"
+		case c >= 'A' && c <= 'Z':
+			return "upper"
+		}
+		return "digit"
+	}
+
+	counts := lawtest.Histogram(lawtest.StringGen(8), 6200, class)
+	if counts["lower"]+counts["upper"]+counts["digit"] != 6200 || len(counts) != 3 {
+		t.Fatalf("Expected every sample in one of three buckets, got %v", counts)
+	}
+
+	// 10 of the 62 characters are digits
+	if counts["digit"] < 700 || counts["digit"] > 1300 {
+		t.Errorf("Expected about 1000 digits, got %v", counts)
+	}
+
+	if counts := lawtest.Histogram(lawtest.IntGen(0, 9), 0, strconv.Itoa); len(counts) != 0 {
+		t.Errorf("Expected no buckets without samples, got %v", counts)
+	}
+}
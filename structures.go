@@ -0,0 +1,507 @@
+package lawtest
+
+import (
+	"cmp"
+	"testing"
+)
+
+// ===========================================================================
+// ABELIAN GROUPS
+// ===========================================================================
+
+// TestAbelianGroup verifies all group properties plus commutativity.
+//
+// Tests performed:
+//   - Everything TestGroup checks
+//   - Commutativity: a ∘ b = b ∘ a
+//
+// Example:
+//
+//	func TestClockArithmetic(t *testing.T) {
+//	    lawtest.TestAbelianGroup(t, IntAddMod12{})
+//	}
+func TestAbelianGroup[T comparable](t *testing.T, g Group[T]) {
+	TestAbelianGroupWithConfig(t, g, DefaultConfig())
+}
+
+// TestAbelianGroupWithConfig verifies abelian group properties with custom configuration.
+func TestAbelianGroupWithConfig[T comparable](t *testing.T, g Group[T], cfg *Config) {
+	t.Helper()
+
+	TestGroupWithConfig(t, g, cfg)
+
+	t.Run("Commutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, g.Op, g.Gen, cfg)
+	})
+}
+
+// IsAbelian reports whether g appears commutative, checking a ∘ b = b ∘ a
+// on cfg.TestCases sampled pairs without a *testing.T.
+//
+// On the first violation it returns false and the witness pair a, b. A
+// true result is only as strong as the sampling, except for a FiniteGroup
+// of up to 316 elements, whose every pair is checked so that the answer is
+// definitive.
+//
+// Example:
+//
+//	if ok, witness := lawtest.IsAbelian[Perm](S3{}, lawtest.DefaultConfig()); !ok {
+//	    fmt.Printf("S3 is not abelian: %v∘%v != %v∘%v\n", witness[0], witness[1], witness[1], witness[0])
+//	}
+func IsAbelian[T comparable](g Group[T], cfg *Config) (bool, []T) {
+	gen := Generator[T](g.Gen)
+	if f, ok := g.(FiniteGroup[T]); ok {
+		gen, cfg = domainGen(cfg, f.Domain(), 2)
+	}
+
+	res := CheckCommutative(g.Op, gen, cfg)
+	return res.Passed, res.Counterexample
+}
+
+// ===========================================================================
+// COMMUTATIVE AND IDEMPOTENT SEMIGROUPS
+// ===========================================================================
+
+// CommutativeSemigroup represents a semigroup whose operation is also
+// commutative: a ∘ b = b ∘ a.
+//
+// It has the same methods as Semigroup; the separate name documents the
+// stronger contract that TestCommutativeSemigroup verifies.
+//
+// Example implementation:
+//
+//	type Sum struct{}
+//
+//	func (s Sum) Op(a, b int) int { return a + b }
+//	func (s Sum) Gen() int        { return lawtest.IntGen(-100, 100)() }
+type CommutativeSemigroup[T comparable] interface {
+	Semigroup[T]
+}
+
+// TestCommutativeSemigroup verifies all semigroup properties plus commutativity.
+//
+// Tests performed:
+//   - Everything TestSemigroup checks
+//   - Commutativity: a ∘ b = b ∘ a
+//
+// Example:
+//
+//	func TestSum(t *testing.T) {
+//	    lawtest.TestCommutativeSemigroup[int](t, Sum{})
+//	}
+func TestCommutativeSemigroup[T comparable](t *testing.T, s CommutativeSemigroup[T]) {
+	TestCommutativeSemigroupWithConfig(t, s, DefaultConfig())
+}
+
+// TestCommutativeSemigroupWithConfig verifies commutative semigroup properties with custom configuration.
+func TestCommutativeSemigroupWithConfig[T comparable](t *testing.T, s CommutativeSemigroup[T], cfg *Config) {
+	t.Helper()
+
+	TestSemigroupWithConfig[T](t, s, cfg)
+
+	t.Run("Commutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, s.Op, s.Gen, cfg)
+	})
+}
+
+// TestBand verifies that a semigroup is a band: its operation is also
+// idempotent, a ∘ a = a.
+//
+// Tests performed:
+//   - Everything TestSemigroup checks
+//   - Idempotence: a ∘ a = a
+//
+// Example:
+//
+//	// Left projection: a ∘ b = a is a band that is not commutative
+//	func TestLeftProjection(t *testing.T) {
+//	    lawtest.TestBand[int](t, LeftProjection{})
+//	}
+func TestBand[T comparable](t *testing.T, s Semigroup[T]) {
+	TestBandWithConfig(t, s, DefaultConfig())
+}
+
+// TestBandWithConfig verifies band properties with custom configuration.
+func TestBandWithConfig[T comparable](t *testing.T, s Semigroup[T], cfg *Config) {
+	t.Helper()
+
+	TestSemigroupWithConfig(t, s, cfg)
+
+	t.Run("Idempotence", func(t *testing.T) {
+		BinaryIdempotentWithConfig(t, s.Op, s.Gen, cfg)
+	})
+}
+
+// TestSemilattice verifies that a semigroup is a semilattice: its
+// operation is commutative and idempotent.
+//
+// Tests performed:
+//   - Everything TestSemigroup checks
+//   - Commutativity: a ∘ b = b ∘ a
+//   - Idempotence: a ∘ a = a
+//
+// Min, max, set union and intersection, gcd and lcm are all semilattices;
+// a semilattice is half of a lattice (see TestLattice).
+//
+// Example:
+//
+//	func TestMin(t *testing.T) {
+//	    lawtest.TestSemilattice[int](t, Min{})
+//	}
+func TestSemilattice[T comparable](t *testing.T, s Semigroup[T]) {
+	TestSemilatticeWithConfig(t, s, DefaultConfig())
+}
+
+// TestSemilatticeWithConfig verifies semilattice properties with custom configuration.
+func TestSemilatticeWithConfig[T comparable](t *testing.T, s Semigroup[T], cfg *Config) {
+	t.Helper()
+
+	TestCommutativeSemigroupWithConfig[T](t, s, cfg)
+
+	t.Run("Idempotence", func(t *testing.T) {
+		BinaryIdempotentWithConfig(t, s.Op, s.Gen, cfg)
+	})
+}
+
+// ===========================================================================
+// ORDERED GROUPS
+// ===========================================================================
+
+// TestOrderedGroup verifies all group properties plus compatibility of the
+// operation with the natural order of T.
+//
+// Tests performed:
+//   - Everything TestGroup checks
+//   - Translation invariance: a ≤ b implies a ∘ c ≤ b ∘ c and c ∘ a ≤ c ∘ b
+//
+// Translation invariance says every map x ↦ x ∘ c is Monotonic. It catches
+// custom numeric types whose operation and comparison disagree, such as
+// wrapping arithmetic or a broken fixed-point addition.
+//
+// Example:
+//
+//	func TestIntegerAddition(t *testing.T) {
+//	    lawtest.TestOrderedGroup[int](t, IntAdd{})
+//	}
+func TestOrderedGroup[T cmp.Ordered](t *testing.T, g Group[T]) {
+	TestOrderedGroupWithConfig(t, g, DefaultConfig())
+}
+
+// TestOrderedGroupWithConfig verifies ordered group properties with custom configuration.
+func TestOrderedGroupWithConfig[T cmp.Ordered](t *testing.T, g Group[T], cfg *Config) {
+	t.Helper()
+
+	TestGroupWithConfig(t, g, cfg)
+
+	t.Run("TranslationInvariance", func(t *testing.T) {
+		checkCases(t, cfg, func(int) string {
+			a, b, c := g.Gen(), g.Gen(), g.Gen()
+			if b < a {
+				a, b = b, a
+			}
+
+			// a ≤ b ⟹ a ∘ c ≤ b ∘ c
+			if ac, bc := g.Op(a, c), g.Op(b, c); ac > bc {
+				return cfg.sprintf("Translation invariance failed: a ≤ b but a∘c > b∘c\n  a=%v, b=%v, c=%v\n  a∘c=%v, b∘c=%v",
+					a, b, c, ac, bc)
+			}
+
+			// a ≤ b ⟹ c ∘ a ≤ c ∘ b
+			if ca, cb := g.Op(c, a), g.Op(c, b); ca > cb {
+				return cfg.sprintf("Translation invariance failed: a ≤ b but c∘a > c∘b\n  a=%v, b=%v, c=%v\n  c∘a=%v, c∘b=%v",
+					a, b, c, ca, cb)
+			}
+
+			return ""
+		})
+	})
+}
+
+// ===========================================================================
+// LATTICES
+// ===========================================================================
+
+// Lattice represents an algebraic lattice with join (∨) and meet (∧).
+//
+// A Lattice must satisfy, for both join and meet:
+//   - Associativity: (a ∨ b) ∨ c = a ∨ (b ∨ c)
+//   - Commutativity: a ∨ b = b ∨ a
+//   - Idempotence: a ∨ a = a
+//
+// and the absorption laws linking them:
+//   - a ∨ (a ∧ b) = a
+//   - a ∧ (a ∨ b) = a
+//
+// Example implementation:
+//
+//	type Bitmask struct{}
+//
+//	func (l Bitmask) Join(a, b uint8) uint8 { return a | b }
+//	func (l Bitmask) Meet(a, b uint8) uint8 { return a & b }
+//	func (l Bitmask) Gen() uint8           { return uint8(rand.Intn(256)) }
+//
+//	func TestBitmaskLattice(t *testing.T) {
+//	    lawtest.TestLattice(t, Bitmask{})
+//	}
+type Lattice[T comparable] interface {
+	// Join returns the least upper bound: a ∨ b
+	Join(a, b T) T
+
+	// Meet returns the greatest lower bound: a ∧ b
+	Meet(a, b T) T
+
+	// Gen generates a random element for testing
+	Gen() T
+}
+
+// TestLattice verifies all lattice properties for a type implementing the Lattice interface.
+//
+// Tests performed:
+//   - Associativity of join and meet
+//   - Commutativity of join and meet
+//   - Idempotence of join and meet: a ∨ a = a, a ∧ a = a
+//   - Absorption: a ∨ (a ∧ b) = a, a ∧ (a ∨ b) = a
+//
+// Example:
+//
+//	func TestAccessSets(t *testing.T) {
+//	    lawtest.TestLattice(t, PermissionSet{})
+//	}
+func TestLattice[T comparable](t *testing.T, l Lattice[T]) {
+	TestLatticeWithConfig(t, l, DefaultConfig())
+}
+
+// TestLatticeWithConfig verifies lattice properties with custom configuration.
+func TestLatticeWithConfig[T comparable](t *testing.T, l Lattice[T], cfg *Config) {
+	t.Helper()
+
+	t.Run("JoinAssociativity", func(t *testing.T) {
+		AssociativeWithConfig(t, l.Join, l.Gen, cfg)
+	})
+
+	t.Run("MeetAssociativity", func(t *testing.T) {
+		AssociativeWithConfig(t, l.Meet, l.Gen, cfg)
+	})
+
+	t.Run("JoinCommutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, l.Join, l.Gen, cfg)
+	})
+
+	t.Run("MeetCommutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, l.Meet, l.Gen, cfg)
+	})
+
+	t.Run("JoinIdempotence", func(t *testing.T) {
+		BinaryIdempotentWithConfig(t, l.Join, l.Gen, cfg)
+	})
+
+	t.Run("MeetIdempotence", func(t *testing.T) {
+		BinaryIdempotentWithConfig(t, l.Meet, l.Gen, cfg)
+	})
+
+	t.Run("Absorption", func(t *testing.T) {
+		AbsorptionWithConfig(t, l.Join, l.Meet, l.Gen, cfg)
+	})
+}
+
+// ===========================================================================
+// BOOLEAN ALGEBRAS
+// ===========================================================================
+
+// BooleanAlgebra represents a complemented distributive lattice with
+// And (∧), Or (∨), Not (¬), a greatest element Top (⊤) and a least
+// element Bottom (⊥).
+//
+// Example implementation:
+//
+//	type Permissions struct{}
+//
+//	func (Permissions) And(a, b uint8) uint8 { return a & b }
+//	func (Permissions) Or(a, b uint8) uint8  { return a | b }
+//	func (Permissions) Not(a uint8) uint8    { return ^a }
+//	func (Permissions) Top() uint8           { return 0xFF }
+//	func (Permissions) Bottom() uint8        { return 0x00 }
+//	func (Permissions) Gen() uint8           { return uint8(rand.Intn(256)) }
+type BooleanAlgebra[T comparable] interface {
+	// And returns the meet: a ∧ b
+	And(a, b T) T
+
+	// Or returns the join: a ∨ b
+	Or(a, b T) T
+
+	// Not returns the complement: ¬a
+	Not(a T) T
+
+	// Top returns the greatest element ⊤
+	Top() T
+
+	// Bottom returns the least element ⊥
+	Bottom() T
+
+	// Gen generates a random element for testing
+	Gen() T
+}
+
+// TestBooleanAlgebra verifies all boolean algebra properties for a type
+// implementing the BooleanAlgebra interface.
+//
+// Tests performed:
+//   - Associativity and commutativity of And and Or
+//   - Distributivity of And over Or, and of Or over And
+//   - Absorption: a ∨ (a ∧ b) = a, a ∧ (a ∨ b) = a
+//   - Complement: a ∧ ¬a = ⊥, a ∨ ¬a = ⊤
+//   - De Morgan: ¬(a ∧ b) = ¬a ∨ ¬b, ¬(a ∨ b) = ¬a ∧ ¬b
+//
+// Example:
+//
+//	func TestPermissions(t *testing.T) {
+//	    lawtest.TestBooleanAlgebra(t, Permissions{})
+//	}
+func TestBooleanAlgebra[T comparable](t *testing.T, ba BooleanAlgebra[T]) {
+	TestBooleanAlgebraWithConfig(t, ba, DefaultConfig())
+}
+
+// TestBooleanAlgebraWithConfig verifies boolean algebra properties with custom configuration.
+func TestBooleanAlgebraWithConfig[T comparable](t *testing.T, ba BooleanAlgebra[T], cfg *Config) {
+	t.Helper()
+
+	t.Run("AndAssociativity", func(t *testing.T) {
+		AssociativeWithConfig(t, ba.And, ba.Gen, cfg)
+	})
+
+	t.Run("OrAssociativity", func(t *testing.T) {
+		AssociativeWithConfig(t, ba.Or, ba.Gen, cfg)
+	})
+
+	t.Run("AndCommutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, ba.And, ba.Gen, cfg)
+	})
+
+	t.Run("OrCommutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, ba.Or, ba.Gen, cfg)
+	})
+
+	t.Run("AndDistributesOverOr", func(t *testing.T) {
+		DistributiveWithConfig(t, ba.And, ba.Or, ba.Gen, cfg)
+	})
+
+	t.Run("OrDistributesOverAnd", func(t *testing.T) {
+		DistributiveWithConfig(t, ba.Or, ba.And, ba.Gen, cfg)
+	})
+
+	t.Run("Absorption", func(t *testing.T) {
+		AbsorptionWithConfig(t, ba.Or, ba.And, ba.Gen, cfg)
+	})
+
+	t.Run("Complement", func(t *testing.T) {
+		ComplementWithConfig(t, ba.And, ba.Or, ba.Not, ba.Top(), ba.Bottom(), ba.Gen, cfg)
+	})
+
+	t.Run("DeMorgan", func(t *testing.T) {
+		DeMorganWithConfig(t, ba.And, ba.Or, ba.Not, ba.Gen, cfg)
+	})
+}
+
+// ===========================================================================
+// FIELDS
+// ===========================================================================
+
+// Field represents a field: a commutative ring in which every nonzero
+// element has a multiplicative inverse.
+//
+// Example implementation, the integers mod 7:
+//
+//	type GF7 struct{}
+//
+//	func (GF7) Add(a, b int) int { return (a + b) % 7 }
+//	func (GF7) Mul(a, b int) int { return a * b % 7 }
+//	func (GF7) Zero() int        { return 0 }
+//	func (GF7) One() int         { return 1 }
+//	func (GF7) Neg(a int) int    { return (7 - a) % 7 }
+//	func (GF7) Inv(a int) int    { return lawtest.Power(GF7{}.Mul, 1, a, 5) } // a⁵ = a⁻¹ by Fermat
+//	func (GF7) Gen() int         { return rand.Intn(7) }
+type Field[T comparable] interface {
+	// Add performs field addition: a + b
+	Add(a, b T) T
+
+	// Mul performs field multiplication: a · b
+	Mul(a, b T) T
+
+	// Zero returns the additive identity 0
+	Zero() T
+
+	// One returns the multiplicative identity 1
+	One() T
+
+	// Neg returns the additive inverse -a
+	Neg(a T) T
+
+	// Inv returns the multiplicative inverse a⁻¹; it is only called with a ≠ 0
+	Inv(a T) T
+
+	// Gen generates a random element for testing
+	Gen() T
+}
+
+// TestField verifies all field axioms for a type implementing the Field
+// interface.
+//
+// Tests performed:
+//   - Addition forms an abelian group: associativity, commutativity,
+//     identity 0, inverse -a
+//   - Multiplication is associative and commutative with identity 1
+//   - Distributivity of multiplication over addition
+//   - Every nonzero a has a · a⁻¹ = a⁻¹ · a = 1, and 0 ≠ 1
+//
+// Inv is never called with zero: generated zeros are skipped in the inverse
+// check, which fails if Gen produced nothing but zeros.
+//
+// Example:
+//
+//	func TestGF7(t *testing.T) {
+//	    lawtest.TestField[int](t, GF7{})
+//	}
+func TestField[T comparable](t *testing.T, f Field[T]) {
+	TestFieldWithConfig(t, f, DefaultConfig())
+}
+
+// TestFieldWithConfig verifies field axioms with custom configuration.
+func TestFieldWithConfig[T comparable](t *testing.T, f Field[T], cfg *Config) {
+	t.Helper()
+
+	t.Run("AddAssociativity", func(t *testing.T) {
+		AssociativeWithConfig(t, f.Add, f.Gen, cfg)
+	})
+
+	t.Run("AddCommutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, f.Add, f.Gen, cfg)
+	})
+
+	t.Run("AddIdentity", func(t *testing.T) {
+		IdentityWithConfig(t, f.Add, f.Zero(), f.Gen, cfg)
+	})
+
+	t.Run("AddInverse", func(t *testing.T) {
+		InverseWithConfig(t, f.Add, f.Neg, f.Zero(), f.Gen, cfg)
+	})
+
+	t.Run("MulAssociativity", func(t *testing.T) {
+		AssociativeWithConfig(t, f.Mul, f.Gen, cfg)
+	})
+
+	t.Run("MulCommutativity", func(t *testing.T) {
+		CommutativeWithConfig(t, f.Mul, f.Gen, cfg)
+	})
+
+	t.Run("MulIdentity", func(t *testing.T) {
+		IdentityWithConfig(t, f.Mul, f.One(), f.Gen, cfg)
+	})
+
+	t.Run("Distributivity", func(t *testing.T) {
+		DistributiveWithConfig(t, f.Mul, f.Add, f.Gen, cfg)
+	})
+
+	t.Run("MulInverse", func(t *testing.T) {
+		MultiplicativeInverseWithConfig(t, f.Mul, f.Inv, f.Zero(), f.One(), f.Gen, cfg)
+	})
+}
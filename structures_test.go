@@ -0,0 +1,198 @@
+package lawtest_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Bitmask lattice under OR/AND
+type BitmaskLattice struct{}
+
+func (l BitmaskLattice) Join(a, b uint8) uint8 { return a | b }
+func (l BitmaskLattice) Meet(a, b uint8) uint8 { return a & b }
+func (l BitmaskLattice) Gen() uint8            { return uint8(rand.Intn(256)) }
+
+// Integers under max/min
+type MinMaxLattice struct{}
+
+func (l MinMaxLattice) Join(a, b int) int { return max(a, b) }
+func (l MinMaxLattice) Meet(a, b int) int { return min(a, b) }
+func (l MinMaxLattice) Gen() int          { return lawtest.IntGen(-100, 100)() }
+
+func TestLattices(t *testing.T) {
+	t.Run("Bitmask", func(t *testing.T) {
+		lawtest.TestLattice[uint8](t, BitmaskLattice{})
+	})
+
+	t.Run("MinMax", func(t *testing.T) {
+		lawtest.TestLattice[int](t, MinMaxLattice{})
+	})
+}
+
+func TestAbelianGroups(t *testing.T) {
+	t.Run("IntMod12", func(t *testing.T) {
+		lawtest.TestAbelianGroup[int](t, IntModGroup{modulus: 12})
+	})
+
+	t.Run("WithConfig", func(t *testing.T) {
+		cfg := lawtest.DefaultConfig()
+		cfg.TestCases = 300
+		lawtest.TestAbelianGroupWithConfig[int](t, IntAdditionGroup{}, cfg)
+	})
+}
+
+// Integers under addition, with no identity required
+type SumSemigroup struct{}
+
+func (s SumSemigroup) Op(a, b int) int { return a + b }
+func (s SumSemigroup) Gen() int        { return lawtest.IntGen(-100, 100)() }
+
+// Strings where a ∘ b = a: idempotent but not commutative
+type LeftProjection struct{}
+
+func (s LeftProjection) Op(a, b string) string { return a }
+func (s LeftProjection) Gen() string           { return lawtest.StringGen(5)() }
+
+func TestSemigroupVariants(t *testing.T) {
+	t.Run("CommutativeSum", func(t *testing.T) {
+		lawtest.TestCommutativeSemigroup[int](t, SumSemigroup{})
+	})
+
+	t.Run("LeftProjectionBand", func(t *testing.T) {
+		lawtest.TestBand[string](t, LeftProjection{})
+	})
+
+	t.Run("MaxSemilattice", func(t *testing.T) {
+		lawtest.TestSemilattice[int](t, MaxSemigroup{})
+	})
+}
+
+// Multiples of 1/4 under addition, which floats represent exactly
+type QuarterGroup struct{}
+
+func (g QuarterGroup) Op(a, b float64) float64   { return a + b }
+func (g QuarterGroup) Identity() float64         { return 0 }
+func (g QuarterGroup) Inverse(a float64) float64 { return -a }
+func (g QuarterGroup) Gen() float64              { return float64(lawtest.IntGen(-10000, 10000)()) / 4 }
+
+func TestOrderedGroups(t *testing.T) {
+	t.Run("Integers", func(t *testing.T) {
+		lawtest.TestOrderedGroup[int](t, IntAdditionGroup{})
+	})
+
+	t.Run("Quarters", func(t *testing.T) {
+		lawtest.TestOrderedGroup[float64](t, QuarterGroup{})
+	})
+}
+
+// Bitmasks under AND/OR/NOT
+type BitmaskAlgebra struct{}
+
+func (BitmaskAlgebra) And(a, b uint8) uint8 { return a & b }
+func (BitmaskAlgebra) Or(a, b uint8) uint8  { return a | b }
+func (BitmaskAlgebra) Not(a uint8) uint8    { return ^a }
+func (BitmaskAlgebra) Top() uint8           { return 0xFF }
+func (BitmaskAlgebra) Bottom() uint8        { return 0x00 }
+func (BitmaskAlgebra) Gen() uint8           { return uint8(rand.Intn(256)) }
+
+// Booleans under &&/||/!
+type BoolAlgebra struct{}
+
+func (BoolAlgebra) And(a, b bool) bool { return a && b }
+func (BoolAlgebra) Or(a, b bool) bool  { return a || b }
+func (BoolAlgebra) Not(a bool) bool    { return !a }
+func (BoolAlgebra) Top() bool          { return true }
+func (BoolAlgebra) Bottom() bool       { return false }
+func (BoolAlgebra) Gen() bool          { return lawtest.BoolGen()() }
+
+func TestBooleanAlgebras(t *testing.T) {
+	t.Run("Bitmask", func(t *testing.T) {
+		lawtest.TestBooleanAlgebra[uint8](t, BitmaskAlgebra{})
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		lawtest.TestBooleanAlgebra[bool](t, BoolAlgebra{})
+	})
+}
+
+// Integers mod 7, a field
+type GF7 struct{}
+
+func (GF7) Add(a, b int) int { return (a + b) % 7 }
+func (GF7) Mul(a, b int) int { return a * b % 7 }
+func (GF7) Zero() int        { return 0 }
+func (GF7) One() int         { return 1 }
+func (GF7) Neg(a int) int    { return (7 - a) % 7 }
+func (GF7) Inv(a int) int    { return lawtest.Power(GF7{}.Mul, 1, a, 5) }
+func (GF7) Gen() int         { return rand.Intn(7) }
+
+// Integers mod 6, a ring but not a field: 2, 3 and 4 have no inverse
+type Z6 struct{}
+
+func (Z6) Add(a, b int) int { return (a + b) % 6 }
+func (Z6) Mul(a, b int) int { return a * b % 6 }
+func (Z6) Zero() int        { return 0 }
+func (Z6) One() int         { return 1 }
+func (Z6) Neg(a int) int    { return (6 - a) % 6 }
+func (Z6) Inv(a int) int    { return lawtest.Power(Z6{}.Mul, 1, a, 5) }
+func (Z6) Gen() int         { return rand.Intn(6) }
+
+func TestFields(t *testing.T) {
+	t.Run("GF7", func(t *testing.T) {
+		lawtest.TestField[int](t, GF7{})
+	})
+
+	t.Run("Z6NotAField", func(t *testing.T) {
+		log := &failureLog{}
+		lawtest.MultiplicativeInverse(log, Z6{}.Mul, Z6{}.Inv, 0, 1, lawtest.IntGen(2, 4))
+		if len(log.errors) != 1 || !strings.Contains(log.errors[0], "Multiplicative inverse failed: a·a⁻¹ != 1") {
+			t.Errorf("Expected Z6 to have elements without an inverse, got %q", log.errors)
+		}
+	})
+
+	t.Run("OnlyZeros", func(t *testing.T) {
+		log := &failureLog{}
+		lawtest.MultiplicativeInverse(log, GF7{}.Mul, GF7{}.Inv, 0, 1, lawtest.IntGen(0, 0))
+		if len(log.errors) != 1 || !strings.Contains(log.errors[0], "only zeros") {
+			t.Errorf("Expected an all-zero generator to be reported, got %q", log.errors)
+		}
+	})
+}
+
+// Permutations of three elements under composition, the smallest
+// non-abelian group
+type S3 struct{}
+
+var s3Elements = [][3]int{{0, 1, 2}, {1, 0, 2}, {0, 2, 1}, {2, 1, 0}, {1, 2, 0}, {2, 0, 1}}
+
+func (S3) Op(a, b [3]int) [3]int { return [3]int{a[b[0]], a[b[1]], a[b[2]]} }
+func (S3) Identity() [3]int      { return [3]int{0, 1, 2} }
+func (S3) Inverse(a [3]int) [3]int {
+	var inv [3]int
+	for i, v := range a {
+		inv[v] = i
+	}
+	return inv
+}
+func (S3) Gen() [3]int      { return s3Elements[rand.Intn(len(s3Elements))] }
+func (S3) Domain() [][3]int { return s3Elements }
+
+func TestIsAbelian(t *testing.T) {
+	lawtest.TestGroup[[3]int](t, S3{})
+
+	if ok, witness := lawtest.IsAbelian[int](IntModGroup{modulus: 12}, lawtest.DefaultConfig()); !ok {
+		t.Errorf("Expected addition mod 12 to be abelian, got witness %v", witness)
+	}
+
+	ok, witness := lawtest.IsAbelian[[3]int](S3{}, lawtest.DefaultConfig())
+	if ok || len(witness) != 2 {
+		t.Fatalf("Expected S3 to be non-abelian with a witness pair, got %v, %v", ok, witness)
+	}
+	g, a, b := S3{}, witness[0], witness[1]
+	if g.Op(a, b) == g.Op(b, a) {
+		t.Errorf("Expected the witness to fail to commute, got %v and %v", a, b)
+	}
+}
@@ -0,0 +1,112 @@
+package lawtest
+
+import "testing"
+
+// ===========================================================================
+// TABLE-DRIVEN PROPERTIES
+// ===========================================================================
+
+// Law selects the properties RunTable checks for a PropertyCase. Laws
+// combine with |.
+type Law uint
+
+const (
+	LawAssociative Law = 1 << iota // (a ∘ b) ∘ c = a ∘ (b ∘ c)
+	LawCommutative                 // a ∘ b = b ∘ a
+	LawIdentity                    // a ∘ e = e ∘ a = a, with e from PropertyCase.Identity
+	LawInverse                     // a ∘ a⁻¹ = a⁻¹ ∘ a = e, with a⁻¹ from PropertyCase.Inverse
+	LawIdempotent                  // a ∘ a = a
+	LawClosure                     // a ∘ b stays in T
+
+	LawsMonoid = LawAssociative | LawIdentity
+	LawsGroup  = LawAssociative | LawIdentity | LawInverse
+)
+
+// PropertyCase is one row of a RunTable table: an operation, the generator
+// its operands are drawn from, and the laws it is expected to satisfy.
+type PropertyCase[T comparable] struct {
+	Name     string       // Subtest name
+	Op       BinaryOp[T]  // Operation under test
+	Gen      Generator[T] // Operand generator
+	Identity T            // Identity element, used by LawIdentity and LawInverse
+	Inverse  UnaryOp[T]   // Inverse function, required by LawInverse
+	Laws     Law          // Laws to check
+	Config   *Config      // Configuration for every law (nil uses DefaultConfig)
+}
+
+// RunTable checks each case's laws as a subtest named after the case, with
+// one nested subtest per law.
+//
+// It replaces a t.Run block per operation and property with a table, the
+// way table-driven tests are written elsewhere in Go. Each law is
+// dispatched to the corresponding WithConfig function, so failures read
+// exactly as they would when calling it directly.
+//
+// Example:
+//
+//	func TestArithmetic(t *testing.T) {
+//	    gen := lawtest.IntGen(-100, 100)
+//	    lawtest.RunTable(t, []lawtest.PropertyCase[int]{
+//	        {Name: "Add", Op: add, Gen: gen, Identity: 0, Inverse: neg, Laws: lawtest.LawsGroup | lawtest.LawCommutative},
+//	        {Name: "Mul", Op: mul, Gen: gen, Identity: 1, Laws: lawtest.LawsMonoid | lawtest.LawCommutative},
+//	        {Name: "Max", Op: maxOp, Gen: gen, Laws: lawtest.LawAssociative | lawtest.LawIdempotent},
+//	    })
+//	}
+//	// --- FAIL: TestArithmetic/Mul/Identity
+func RunTable[T comparable](t *testing.T, cases []PropertyCase[T]) {
+	t.Helper()
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			runCase(t, c)
+		})
+	}
+}
+
+// runCase checks the laws selected by c, one subtest per law.
+func runCase[T comparable](t *testing.T, c PropertyCase[T]) {
+	t.Helper()
+
+	if c.Laws == 0 {
+		t.Fatalf("PropertyCase %q selects no laws", c.Name)
+	}
+	if c.Laws&LawInverse != 0 && c.Inverse == nil {
+		t.Fatalf("PropertyCase %q checks LawInverse but has no Inverse", c.Name)
+	}
+
+	cfg := c.Config
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+
+	if c.Laws&LawAssociative != 0 {
+		t.Run("Associativity", func(t *testing.T) {
+			AssociativeWithConfig(t, c.Op, c.Gen, cfg)
+		})
+	}
+	if c.Laws&LawCommutative != 0 {
+		t.Run("Commutativity", func(t *testing.T) {
+			CommutativeWithConfig(t, c.Op, c.Gen, cfg)
+		})
+	}
+	if c.Laws&LawIdentity != 0 {
+		t.Run("Identity", func(t *testing.T) {
+			IdentityWithConfig(t, c.Op, c.Identity, c.Gen, cfg)
+		})
+	}
+	if c.Laws&LawInverse != 0 {
+		t.Run("Inverse", func(t *testing.T) {
+			InverseWithConfig(t, c.Op, c.Inverse, c.Identity, c.Gen, cfg)
+		})
+	}
+	if c.Laws&LawIdempotent != 0 {
+		t.Run("Idempotence", func(t *testing.T) {
+			BinaryIdempotentWithConfig(t, c.Op, c.Gen, cfg)
+		})
+	}
+	if c.Laws&LawClosure != 0 {
+		t.Run("Closure", func(t *testing.T) {
+			ClosureWithConfig(t, c.Op, c.Gen, cfg)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package lawtest_test
+
+import (
+	"testing"
+
+	"github.com/alexshd/lawtest"
+)
+
+// Test that a table of operations runs each selected law as a subtest
+func TestRunTable(t *testing.T) {
+	gen := lawtest.IntGen(-100, 100)
+	add := func(a, b int) int { return a + b }
+	neg := func(a int) int { return -a }
+	mul := func(a, b int) int { return a * b }
+	maxOp := func(a, b int) int { return max(a, b) }
+
+	cfg := lawtest.DefaultConfig()
+	cfg.TestCases = 50
+
+	lawtest.RunTable(t, []lawtest.PropertyCase[int]{
+		{Name: "Add", Op: add, Gen: gen, Identity: 0, Inverse: neg, Laws: lawtest.LawsGroup | lawtest.LawCommutative | lawtest.LawClosure},
+		{Name: "Mul", Op: mul, Gen: gen, Identity: 1, Laws: lawtest.LawsMonoid | lawtest.LawCommutative},
+		{Name: "Max", Op: maxOp, Gen: gen, Laws: lawtest.LawAssociative | lawtest.LawIdempotent, Config: cfg},
+	})
+}